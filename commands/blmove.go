@@ -0,0 +1,54 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+)
+
+type BLMoveCommand Command
+
+func (cmd *BLMoveCommand) Execute(con *client.Client) RESPValue {
+	// BLMOVE source destination LEFT|RIGHT LEFT|RIGHT timeout
+	if len(cmd.args) != 5 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	fromLeft, ok := parseListDirection(cmd.args[2])
+	if !ok {
+		return resp.EncodeSimpleError(errSyntax)
+	}
+	toLeft, ok := parseListDirection(cmd.args[3])
+	if !ok {
+		return resp.EncodeSimpleError(errSyntax)
+	}
+
+	timeout, err := parseBlockingTimeout(cmd.args[4])
+	if err != nil {
+		return resp.EncodeSimpleError(err.Error())
+	}
+
+	return blockingListMove(con, cmd.args[0], cmd.args[1], fromLeft, toLeft, timeout)
+}
+
+// blockingListMove waits until source has an element and moves it to destination
+// The move is replicated as a plain LMOVE so replicas never block
+func blockingListMove(con *client.Client, source, destination string, fromLeft, toLeft bool, timeout float64) RESPValue {
+	var element string
+	var moveErr error
+	done := blockOnKeys([]string{source}, timeout, func(key string) bool {
+		var moved bool
+		element, moved, moveErr = listMove(source, destination, fromLeft, toLeft)
+		return moved || moveErr != nil
+	})
+
+	if moveErr != nil {
+		return resp.EncodeSimpleError(moveErr.Error())
+	}
+	// A timeout replies with a null array, like the other blocking list commands
+	if !done {
+		return resp.EncodeNullArray()
+	}
+
+	con.Propagate("lmove", source, destination, listDirection(fromLeft), listDirection(toLeft))
+	return resp.EncodeBulkString(element)
+}
@@ -0,0 +1,52 @@
+package command
+
+import (
+	"strconv"
+
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+)
+
+type BLMPopCommand Command
+
+func (cmd *BLMPopCommand) Execute(con *client.Client) RESPValue {
+	// BLMPOP timeout numkeys key [key ...] LEFT|RIGHT [COUNT count]
+	if len(cmd.args) < 4 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	timeout, err := parseBlockingTimeout(cmd.args[0])
+	if err != nil {
+		return resp.EncodeSimpleError(err.Error())
+	}
+
+	req, err := parseMultiPopArgs(cmd.args[1:], parseListDirection)
+	if err != nil {
+		return resp.EncodeSimpleError(err.Error())
+	}
+
+	var servedKey string
+	var elements []string
+	wrongType := false
+	popped := blockOnKeys(req.keys, timeout, func(key string) bool {
+		var ok bool
+		elements, ok = listPopCount(key, req.fromHead, req.count)
+		if !ok {
+			wrongType = true
+			return true
+		}
+		servedKey = key
+		return len(elements) > 0
+	})
+
+	if wrongType {
+		return resp.EncodeSimpleError(errWrongType)
+	}
+	if !popped {
+		return resp.EncodeNullArray()
+	}
+
+	// Replicate as a non-blocking LMPOP of the key that was served
+	con.Propagate("lmpop", "1", servedKey, listDirection(req.fromHead), "count", strconv.Itoa(len(elements)))
+	return encodeMultiPopReply(servedKey, elements)
+}
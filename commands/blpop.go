@@ -1,31 +1,159 @@
 package command
 
 import (
+	"errors"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/SuchintK/GoDisKV/resp"
 	"github.com/SuchintK/GoDisKV/resp/client"
-	"github.com/SuchintK/GoDisKV/store"
 )
 
 type BLPopCommand Command
 
+// blockingPollInterval is how often a blocked client retries its operation
+const blockingPollInterval = 10 * time.Millisecond
+
+// blockedClient identifies one blocking command waiting in the per-key queues
+// It must not be zero-sized: Go may give every pointer to a zero-sized value the same
+// address, which would make all waiters compare equal.
+type blockedClient struct {
+	since time.Time
+}
+
+// blockedQueues holds, per key, the clients blocked on it in the order they blocked
+var blockedQueues = struct {
+	sync.Mutex
+	keys map[string][]*blockedClient
+}{
+	keys: make(map[string][]*blockedClient),
+}
+
 func (cmd *BLPopCommand) Execute(con *client.Client) RESPValue {
-	if len(cmd.args) < 2 {
+	// BLPOP key [key ...] timeout
+	return blockingListPop(con, cmd.args, true)
+}
+
+// blockingListPop implements BLPOP and BRPOP
+// The pop is replicated as a non-blocking LPOP/RPOP of the key that was served
+func blockingListPop(con *client.Client, args []string, fromLeft bool) RESPValue {
+	if len(args) < 2 {
 		return resp.EncodeSimpleError(errWrongNumberOfArgs)
 	}
 
 	// Last argument is timeout
-	timeoutStr := cmd.args[len(cmd.args)-1]
-	timeout, err := strconv.ParseFloat(timeoutStr, 64)
+	timeout, err := parseBlockingTimeout(args[len(args)-1])
 	if err != nil {
-		return resp.EncodeSimpleError("ERR timeout is not a float or out of range")
+		return resp.EncodeSimpleError(err.Error())
 	}
 
 	// Keys are all arguments except the last one
-	keys := cmd.args[:len(cmd.args)-1]
+	keys := args[:len(args)-1]
 
+	var servedKey, element string
+	wrongType := false
+	popped := blockOnKeys(keys, timeout, func(key string) bool {
+		elements, ok := listPopCount(key, fromLeft, 1)
+		if !ok {
+			wrongType = true
+			return true
+		}
+		if len(elements) == 0 {
+			return false
+		}
+		servedKey, element = key, elements[0]
+		return true
+	})
+
+	if wrongType {
+		return resp.EncodeSimpleError(errWrongType)
+	}
+	if !popped {
+		return resp.EncodeNullArray()
+	}
+
+	if fromLeft {
+		con.Propagate("lpop", servedKey)
+	} else {
+		con.Propagate("rpop", servedKey)
+	}
+
+	// Return [key, element]
+	return resp.EncodeArray([][]byte{
+		resp.EncodeBulkString(servedKey),
+		resp.EncodeBulkString(element),
+	})
+}
+
+// parseBlockingTimeout parses the timeout of a blocking command in seconds
+func parseBlockingTimeout(s string) (float64, error) {
+	timeout, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, errors.New("ERR timeout is not a float or out of range")
+	}
+	if timeout < 0 {
+		return 0, errors.New("ERR timeout is negative")
+	}
+	return timeout, nil
+}
+
+// blockOnKeys polls try on each key, in order, until it succeeds or the timeout elapses
+//
+// Clients blocked on the same key are served first come, first served: try is only
+// called for keys where this client is at the head of the key's queue, so a client
+// that started blocking later cannot take an element from an earlier one.
+func blockOnKeys(keys []string, timeout float64, try func(key string) bool) bool {
+	waiter := &blockedClient{since: time.Now()}
+
+	blockedQueues.Lock()
+	for _, key := range keys {
+		blockedQueues.keys[key] = append(blockedQueues.keys[key], waiter)
+	}
+	blockedQueues.Unlock()
+	defer unblockClient(waiter, keys)
+
+	return blockUntil(timeout, func() bool {
+		for _, key := range keys {
+			if isFirstBlocked(waiter, key) && try(key) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// isFirstBlocked reports whether waiter is the next client to be served on key
+func isFirstBlocked(waiter *blockedClient, key string) bool {
+	blockedQueues.Lock()
+	defer blockedQueues.Unlock()
+	queue := blockedQueues.keys[key]
+	return len(queue) > 0 && queue[0] == waiter
+}
+
+// unblockClient removes waiter from the queues of all keys it was blocked on
+func unblockClient(waiter *blockedClient, keys []string) {
+	blockedQueues.Lock()
+	defer blockedQueues.Unlock()
+	for _, key := range keys {
+		queue := blockedQueues.keys[key]
+		for i, w := range queue {
+			if w == waiter {
+				queue = append(queue[:i], queue[i+1:]...)
+				break
+			}
+		}
+		if len(queue) == 0 {
+			delete(blockedQueues.keys, key)
+		} else {
+			blockedQueues.keys[key] = queue
+		}
+	}
+}
+
+// blockUntil polls try until it succeeds or the timeout (in seconds) elapses
+// A timeout of 0 blocks indefinitely. Returns false on timeout.
+func blockUntil(timeout float64, try func() bool) bool {
 	// Calculate deadline
 	var deadline time.Time
 	if timeout > 0 {
@@ -35,39 +163,18 @@ func (cmd *BLPopCommand) Execute(con *client.Client) RESPValue {
 		deadline = time.Now().Add(24 * time.Hour)
 	}
 
-	// Poll for elements with a small sleep interval
-	ticker := time.NewTicker(10 * time.Millisecond)
+	// Poll with a small sleep interval
+	ticker := time.NewTicker(blockingPollInterval)
 	defer ticker.Stop()
 
 	for {
-		// Try to pop from each key in order
-		for _, key := range keys {
-			val, exists := store.Get(key)
-
-			if exists && val.ListData != nil && len(val.ListData) > 0 {
-				// Pop the first element
-				element := val.ListData[0]
-				val.ListData = val.ListData[1:]
-
-				// Update or delete the key
-				if len(val.ListData) == 0 {
-					store.Delete(key)
-				} else {
-					store.Set(key, val)
-				}
-
-				// Return [key, element]
-				result := [][]byte{
-					resp.EncodeBulkString(key),
-					resp.EncodeBulkString(element),
-				}
-				return resp.EncodeArray(result)
-			}
+		if try() {
+			return true
 		}
 
 		// Check timeout
 		if time.Now().After(deadline) {
-			return resp.EncodeNullBulkString()
+			return false
 		}
 
 		// Wait before next poll
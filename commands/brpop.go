@@ -0,0 +1,12 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp/client"
+)
+
+type BRPopCommand Command
+
+func (cmd *BRPopCommand) Execute(con *client.Client) RESPValue {
+	// BRPOP key [key ...] timeout
+	return blockingListPop(con, cmd.args, false)
+}
@@ -0,0 +1,22 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+)
+
+type BRPopLPushCommand Command
+
+func (cmd *BRPopLPushCommand) Execute(con *client.Client) RESPValue {
+	// BRPOPLPUSH source destination timeout
+	if len(cmd.args) != 3 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	timeout, err := parseBlockingTimeout(cmd.args[2])
+	if err != nil {
+		return resp.EncodeSimpleError(err.Error())
+	}
+
+	return blockingListMove(con, cmd.args[0], cmd.args[1], false, true, timeout)
+}
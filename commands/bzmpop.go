@@ -0,0 +1,53 @@
+package command
+
+import (
+	"strconv"
+
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+type BZMPopCommand Command
+
+func (cmd *BZMPopCommand) Execute(con *client.Client) RESPValue {
+	// BZMPOP timeout numkeys key [key ...] MIN|MAX [COUNT count]
+	if len(cmd.args) < 4 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	timeout, err := parseBlockingTimeout(cmd.args[0])
+	if err != nil {
+		return resp.EncodeSimpleError(err.Error())
+	}
+
+	req, err := parseMultiPopArgs(cmd.args[1:], parseZSetWhere)
+	if err != nil {
+		return resp.EncodeSimpleError(err.Error())
+	}
+
+	var servedKey string
+	var items []store.ScoredMember
+	wrongType := false
+	popped := blockOnKeys(req.keys, timeout, func(key string) bool {
+		var ok bool
+		items, ok = zsetPop(key, req.fromHead, req.count)
+		if !ok {
+			wrongType = true
+			return true
+		}
+		servedKey = key
+		return len(items) > 0
+	})
+
+	if wrongType {
+		return resp.EncodeSimpleError(errWrongType)
+	}
+	if !popped {
+		return resp.EncodeNullArray()
+	}
+
+	// Replicate as a non-blocking ZMPOP of the key that was served
+	con.Propagate("zmpop", "1", servedKey, zsetWhere(req.fromHead), "count", strconv.Itoa(len(items)))
+	return encodeZMPopReply(servedKey, items)
+}
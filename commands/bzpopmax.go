@@ -0,0 +1,12 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp/client"
+)
+
+type BZPopMaxCommand Command
+
+func (cmd *BZPopMaxCommand) Execute(con *client.Client) RESPValue {
+	// BZPOPMAX key [key ...] timeout
+	return blockingZSetPop(con, cmd.args, false)
+}
@@ -0,0 +1,62 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+type BZPopMinCommand Command
+
+func (cmd *BZPopMinCommand) Execute(con *client.Client) RESPValue {
+	// BZPOPMIN key [key ...] timeout
+	return blockingZSetPop(con, cmd.args, true)
+}
+
+// blockingZSetPop implements BZPOPMIN and BZPOPMAX
+// The pop is replicated as a non-blocking ZPOPMIN/ZPOPMAX of the key that was served
+func blockingZSetPop(con *client.Client, args []string, min bool) RESPValue {
+	if len(args) < 2 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	// Last argument is timeout
+	timeout, err := parseBlockingTimeout(args[len(args)-1])
+	if err != nil {
+		return resp.EncodeSimpleError(err.Error())
+	}
+
+	keys := args[:len(args)-1]
+
+	var servedKey string
+	var item store.ScoredMember
+	wrongType := false
+	popped := blockOnKeys(keys, timeout, func(key string) bool {
+		items, ok := zsetPop(key, min, 1)
+		if !ok {
+			wrongType = true
+			return true
+		}
+		if len(items) == 0 {
+			return false
+		}
+		servedKey, item = key, items[0]
+		return true
+	})
+
+	if wrongType {
+		return resp.EncodeSimpleError(errWrongType)
+	}
+	if !popped {
+		return resp.EncodeNullArray()
+	}
+
+	if min {
+		con.Propagate("zpopmin", servedKey)
+	} else {
+		con.Propagate("zpopmax", servedKey)
+	}
+
+	// Return [key, member, score]
+	return resp.EncodeArrayBulk(servedKey, item.Member, formatScore(item.Score))
+}
@@ -11,7 +11,11 @@ const (
 	errWrongType            = "WRONGTYPE Operation against a key holding the wrong kind of value"
 	invalidStreamID         = "ERR Invalid stream ID specified as stream command argument"
 	idGreaterThanTopElement = "ERR The ID specified in XADD is equal or smaller than the target stream top item"
+	idZero                  = "ERR The ID specified in XADD must be greater than 0-0"
+	streamExhausted         = "ERR The stream has exhausted the last possible ID, unable to add more items"
 	errSubscribedMode       = "ERR only (P|S)SUBSCRIBE / (P|S)UNSUBSCRIBE / PING / QUIT / RESET are allowed in this context"
+	errNotInteger           = "ERR value is not an integer or out of range"
+	errNotFloat             = "ERR value is not a valid float"
 )
 
 type RESPValue []byte
@@ -38,6 +42,24 @@ func IsAllowedInSubscribedMode(label string) bool {
 	}
 }
 
+// IsWriteCommand checks if a command mutates the dataset and must be propagated to replicas
+func IsWriteCommand(label string) bool {
+	switch label {
+	case "set", "incr",
+		"hset", "hsetnx", "hdel", "hincrby", "hincrbyfloat",
+		"hexpire", "hpexpire", "hexpireat", "hpexpireat", "hpersist",
+		"sadd", "srem", "smove", "sinterstore", "sunionstore", "sdiffstore",
+		"lpush", "rpush", "lpop", "rpop", "lpushx", "rpushx",
+		"lset", "linsert", "lrem", "ltrim", "lmove", "rpoplpush", "lmpop",
+		"zadd", "zincrby", "zrem", "zrangestore", "zpopmin", "zpopmax", "zmpop",
+		"zunionstore", "zinterstore", "zdiffstore", "zremrangebyrank", "zremrangebyscore", "zremrangebylex",
+		"geoadd", "geosearchstore":
+		return true
+	default:
+		return false
+	}
+}
+
 func New(label string, params []string) Executor {
 	switch label {
 	case "ping":
@@ -60,6 +82,30 @@ func New(label string, params []string) Executor {
 		return &XRangeCommand{label: label, args: params}
 	case "xread":
 		return &XReadCommand{label: label, args: params}
+	case "xgroup":
+		return &XGroupCommand{label: label, args: params}
+	case "xreadgroup":
+		return &XReadGroupCommand{label: label, args: params}
+	case "xack":
+		return &XAckCommand{label: label, args: params}
+	case "xpending":
+		return &XPendingCommand{label: label, args: params}
+	case "xclaim":
+		return &XClaimCommand{label: label, args: params}
+	case "xautoclaim":
+		return &XAutoClaimCommand{label: label, args: params}
+	case "xlen":
+		return &XLenCommand{label: label, args: params}
+	case "xdel":
+		return &XDelCommand{label: label, args: params}
+	case "xtrim":
+		return &XTrimCommand{label: label, args: params}
+	case "xrevrange":
+		return &XRevRangeCommand{label: label, args: params}
+	case "xinfo":
+		return &XInfoCommand{label: label, args: params}
+	case "xsetid":
+		return &XSetIDCommand{label: label, args: params}
 	case "incr":
 		return &IncrCommand{label: label, args: params, IsMutation: true}
 	case "multi":
@@ -72,14 +118,76 @@ func New(label string, params []string) Executor {
 		return &SubscribeCommand{label: label, args: params}
 	case "unsubscribe":
 		return &UnsubscribeCommand{label: label, args: params}
+	case "psubscribe":
+		return &PSubscribeCommand{label: label, args: params}
+	case "punsubscribe":
+		return &PUnsubscribeCommand{label: label, args: params}
 	case "publish":
 		return &PublishCommand{label: label, args: params}
 	case "zadd":
 		return &ZAddCommand{label: label, args: params, IsMutation: true}
+	case "zincrby":
+		return &ZIncrByCommand{label: label, args: params, IsMutation: true}
+	case "zpopmin":
+		return &ZPopMinCommand{label: label, args: params, IsMutation: true}
+	case "zpopmax":
+		return &ZPopMaxCommand{label: label, args: params, IsMutation: true}
+	case "zmpop":
+		return &ZMPopCommand{label: label, args: params, IsMutation: true}
+	case "bzpopmin":
+		return &BZPopMinCommand{label: label, args: params, IsMutation: true}
+	case "bzpopmax":
+		return &BZPopMaxCommand{label: label, args: params, IsMutation: true}
+	case "bzmpop":
+		return &BZMPopCommand{label: label, args: params, IsMutation: true}
+	case "zunion":
+		return &ZUnionCommand{label: label, args: params}
+	case "zinter":
+		return &ZInterCommand{label: label, args: params}
+	case "zdiff":
+		return &ZDiffCommand{label: label, args: params}
+	case "zunionstore":
+		return &ZUnionStoreCommand{label: label, args: params, IsMutation: true}
+	case "zinterstore":
+		return &ZInterStoreCommand{label: label, args: params, IsMutation: true}
+	case "zdiffstore":
+		return &ZDiffStoreCommand{label: label, args: params, IsMutation: true}
+	case "zintercard":
+		return &ZInterCardCommand{label: label, args: params}
 	case "zrank":
 		return &ZRankCommand{label: label, args: params}
+	case "zrevrank":
+		return &ZRevRankCommand{label: label, args: params}
+	case "zrevrange":
+		return &ZRevRangeCommand{label: label, args: params}
+	case "zmscore":
+		return &ZMScoreCommand{label: label, args: params}
+	case "zrandmember":
+		return &ZRandMemberCommand{label: label, args: params}
+	case "zremrangebyrank":
+		return &ZRemRangeByRankCommand{label: label, args: params, IsMutation: true}
+	case "zremrangebyscore":
+		return &ZRemRangeByScoreCommand{label: label, args: params, IsMutation: true}
+	case "zremrangebylex":
+		return &ZRemRangeByLexCommand{label: label, args: params, IsMutation: true}
+	case "zscan":
+		return &ZScanCommand{label: label, args: params}
 	case "zrange":
 		return &ZRangeCommand{label: label, args: params}
+	case "zrangebyscore":
+		return &ZRangeByScoreCommand{label: label, args: params}
+	case "zrevrangebyscore":
+		return &ZRevRangeByScoreCommand{label: label, args: params}
+	case "zrangebylex":
+		return &ZRangeByLexCommand{label: label, args: params}
+	case "zrevrangebylex":
+		return &ZRevRangeByLexCommand{label: label, args: params}
+	case "zcount":
+		return &ZCountCommand{label: label, args: params}
+	case "zlexcount":
+		return &ZLexCountCommand{label: label, args: params}
+	case "zrangestore":
+		return &ZRangeStoreCommand{label: label, args: params, IsMutation: true}
 	case "zcard":
 		return &ZCardCommand{label: label, args: params}
 	case "zscore":
@@ -98,16 +206,134 @@ func New(label string, params []string) Executor {
 		return &LLenCommand{label: label, args: params}
 	case "lrange":
 		return &LRangeCommand{label: label, args: params}
+	case "lindex":
+		return &LIndexCommand{label: label, args: params}
+	case "lset":
+		return &LSetCommand{label: label, args: params, IsMutation: true}
+	case "linsert":
+		return &LInsertCommand{label: label, args: params, IsMutation: true}
+	case "lrem":
+		return &LRemCommand{label: label, args: params, IsMutation: true}
+	case "ltrim":
+		return &LTrimCommand{label: label, args: params, IsMutation: true}
+	case "lpos":
+		return &LPosCommand{label: label, args: params}
+	case "lpushx":
+		return &LPushXCommand{label: label, args: params, IsMutation: true}
+	case "rpushx":
+		return &RPushXCommand{label: label, args: params, IsMutation: true}
 	case "blpop":
 		return &BLPopCommand{label: label, args: params, IsMutation: true}
+	case "brpop":
+		return &BRPopCommand{label: label, args: params, IsMutation: true}
+	case "lmpop":
+		return &LMPopCommand{label: label, args: params, IsMutation: true}
+	case "blmpop":
+		return &BLMPopCommand{label: label, args: params, IsMutation: true}
+	case "lmove":
+		return &LMoveCommand{label: label, args: params, IsMutation: true}
+	case "rpoplpush":
+		return &RPopLPushCommand{label: label, args: params, IsMutation: true}
+	case "blmove":
+		return &BLMoveCommand{label: label, args: params, IsMutation: true}
+	case "brpoplpush":
+		return &BRPopLPushCommand{label: label, args: params, IsMutation: true}
 	case "geoadd":
 		return &GeoAddCommand{label: label, args: params, IsMutation: true}
 	case "geopos":
 		return &GeoPosCommand{label: label, args: params}
 	case "geodist":
 		return &GeoDistCommand{label: label, args: params}
+	case "geohash":
+		return &GeoHashCommand{label: label, args: params}
 	case "georadius":
 		return &GeoRadiusCommand{label: label, args: params}
+	case "georadiusbymember":
+		return &GeoRadiusByMemberCommand{label: label, args: params}
+	case "geofence":
+		return &GeoFenceCommand{label: label, args: params}
+	case "geosearch":
+		return &GeoSearchCommand{label: label, args: params}
+	case "geosearchstore":
+		return &GeoSearchStoreCommand{label: label, args: params, IsMutation: true}
+	case "hset":
+		return &HSetCommand{label: label, args: params, IsMutation: true}
+	case "hsetnx":
+		return &HSetNXCommand{label: label, args: params, IsMutation: true}
+	case "hget":
+		return &HGetCommand{label: label, args: params}
+	case "hmget":
+		return &HMGetCommand{label: label, args: params}
+	case "hgetall":
+		return &HGetAllCommand{label: label, args: params}
+	case "hkeys":
+		return &HKeysCommand{label: label, args: params}
+	case "hvals":
+		return &HValsCommand{label: label, args: params}
+	case "hlen":
+		return &HLenCommand{label: label, args: params}
+	case "hexists":
+		return &HExistsCommand{label: label, args: params}
+	case "hdel":
+		return &HDelCommand{label: label, args: params, IsMutation: true}
+	case "hincrby":
+		return &HIncrByCommand{label: label, args: params, IsMutation: true}
+	case "hincrbyfloat":
+		return &HIncrByFloatCommand{label: label, args: params, IsMutation: true}
+	case "hstrlen":
+		return &HStrLenCommand{label: label, args: params}
+	case "hrandfield":
+		return &HRandFieldCommand{label: label, args: params}
+	case "hscan":
+		return &HScanCommand{label: label, args: params}
+	case "hexpire":
+		return &HExpireCommand{label: label, args: params, IsMutation: true}
+	case "hpexpire":
+		return &HPExpireCommand{label: label, args: params, IsMutation: true}
+	case "hexpireat":
+		return &HExpireAtCommand{label: label, args: params, IsMutation: true}
+	case "hpexpireat":
+		return &HPExpireAtCommand{label: label, args: params, IsMutation: true}
+	case "httl":
+		return &HTTLCommand{label: label, args: params}
+	case "hpttl":
+		return &HPTTLCommand{label: label, args: params}
+	case "hpersist":
+		return &HPersistCommand{label: label, args: params, IsMutation: true}
+	case "sadd":
+		return &SAddCommand{label: label, args: params, IsMutation: true}
+	case "srem":
+		return &SRemCommand{label: label, args: params, IsMutation: true}
+	case "sismember":
+		return &SIsMemberCommand{label: label, args: params}
+	case "smismember":
+		return &SMIsMemberCommand{label: label, args: params}
+	case "scard":
+		return &SCardCommand{label: label, args: params}
+	case "smembers":
+		return &SMembersCommand{label: label, args: params}
+	case "spop":
+		return &SPopCommand{label: label, args: params, IsMutation: true}
+	case "srandmember":
+		return &SRandMemberCommand{label: label, args: params}
+	case "smove":
+		return &SMoveCommand{label: label, args: params, IsMutation: true}
+	case "sinter":
+		return &SInterCommand{label: label, args: params}
+	case "sunion":
+		return &SUnionCommand{label: label, args: params}
+	case "sdiff":
+		return &SDiffCommand{label: label, args: params}
+	case "sinterstore":
+		return &SInterStoreCommand{label: label, args: params, IsMutation: true}
+	case "sunionstore":
+		return &SUnionStoreCommand{label: label, args: params, IsMutation: true}
+	case "sdiffstore":
+		return &SDiffStoreCommand{label: label, args: params, IsMutation: true}
+	case "sintercard":
+		return &SInterCardCommand{label: label, args: params}
+	case "sscan":
+		return &SScanCommand{label: label, args: params}
 	}
 	return &NotImplementedCommand{}
 }
@@ -79,11 +79,19 @@ func (cmd *GeoAddCommand) Execute(con *client.Client) RESPValue {
 		// Encode coordinates to geohash score
 		geohashScore := geohash.Encode(latitude, longitude)
 
+		// Remember where the member was, to detect geofence crossings
+		var previous *float64
+		if score, exists := sortedSet.GetScore(member); exists {
+			previous = &score
+		}
+
 		// Add to sorted set
 		// Returns true if member was newly added, false if score was updated
 		if sortedSet.Add(float64(geohashScore), member) {
 			addedCount++
 		}
+
+		publishGeoFenceCrossings(key, member, previous, float64(geohashScore))
 	}
 
 	return resp.EncodeInteger(int64(addedCount))
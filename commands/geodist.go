@@ -1,6 +1,7 @@
 package command
 
 import (
+	"errors"
 	"strconv"
 	"strings"
 
@@ -33,22 +34,12 @@ func (cmd *GeoDistCommand) Execute(con *client.Client) RESPValue {
 	// Default unit is meters
 	unit := "m"
 	if len(cmd.args) >= 4 {
-		unit = strings.ToLower(cmd.args[3])
+		unit = cmd.args[3]
 	}
 
-	// Validate unit
-	var conversionFactor float64
-	switch unit {
-	case "m":
-		conversionFactor = metersToMeters
-	case "km":
-		conversionFactor = metersToKilometers
-	case "mi":
-		conversionFactor = metersToMiles
-	case "ft":
-		conversionFactor = metersToFeet
-	default:
-		return resp.EncodeSimpleError("ERR unsupported unit provided. please use M, KM, FT, MI")
+	conversionFactor, err := parseGeoUnit(unit)
+	if err != nil {
+		return resp.EncodeSimpleError(err.Error())
 	}
 
 	// Get sorted set
@@ -87,6 +78,22 @@ func (cmd *GeoDistCommand) Execute(con *client.Client) RESPValue {
 	return resp.EncodeBulkString(formatFloat(distance))
 }
 
+// parseGeoUnit returns the factor converting meters to unit
+func parseGeoUnit(unit string) (float64, error) {
+	switch strings.ToLower(unit) {
+	case "m":
+		return metersToMeters, nil
+	case "km":
+		return metersToKilometers, nil
+	case "mi":
+		return metersToMiles, nil
+	case "ft":
+		return metersToFeet, nil
+	default:
+		return 0, errors.New("ERR unsupported unit provided. please use M, KM, FT, MI")
+	}
+}
+
 // formatFloat formats a float64 for RESP output with appropriate precision
 func formatFloat(f float64) string {
 	// Use 'f' format with enough precision, then trim trailing zeros
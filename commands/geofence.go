@@ -0,0 +1,169 @@
+package command
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/SuchintK/GoDisKV/geofence"
+	"github.com/SuchintK/GoDisKV/geohash"
+	"github.com/SuchintK/GoDisKV/pubsub"
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+type GeoFenceCommand Command
+
+// Fences belong to their key and go away with it
+func init() {
+	store.OnRemove(func(key string) {
+		geofence.Global.DeleteKey(key)
+	})
+}
+
+func (cmd *GeoFenceCommand) Execute(con *client.Client) RESPValue {
+	// GEOFENCE ADD key name CIRCLE longitude latitude radius unit
+	// GEOFENCE ADD key name POLYGON longitude latitude longitude latitude longitude latitude [...]
+	// GEOFENCE DEL key name
+	// GEOFENCE LIST key
+	// GEOFENCE MEMBERS key name
+	if len(cmd.args) < 2 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	subcommand := strings.ToUpper(cmd.args[0])
+	args := cmd.args[1:]
+
+	switch subcommand {
+	case "ADD":
+		if len(args) < 3 {
+			return resp.EncodeSimpleError(errWrongNumberOfArgs)
+		}
+		fence, err := parseGeoFence(args[2:])
+		if err != nil {
+			return resp.EncodeSimpleError(err.Error())
+		}
+		con.Propagate(append([]string{cmd.label}, cmd.args...)...)
+		if geofence.Global.Set(args[0], args[1], fence) {
+			return resp.EncodeInteger(1)
+		}
+		return resp.EncodeInteger(0)
+
+	case "DEL":
+		if len(args) != 2 {
+			return resp.EncodeSimpleError(errWrongNumberOfArgs)
+		}
+		if !geofence.Global.Delete(args[0], args[1]) {
+			return resp.EncodeInteger(0)
+		}
+		con.Propagate(append([]string{cmd.label}, cmd.args...)...)
+		return resp.EncodeInteger(1)
+
+	case "LIST":
+		if len(args) != 1 {
+			return resp.EncodeSimpleError(errWrongNumberOfArgs)
+		}
+		return resp.EncodeArrayBulk(geofence.Global.Names(args[0])...)
+
+	case "MEMBERS":
+		if len(args) != 2 {
+			return resp.EncodeSimpleError(errWrongNumberOfArgs)
+		}
+		return geoFenceMembers(args[0], args[1])
+
+	default:
+		return resp.EncodeSimpleError("ERR unknown subcommand '" + cmd.args[0] + "'")
+	}
+}
+
+// parseGeoFence parses the shape of a fence, CIRCLE or POLYGON followed by its coordinates
+func parseGeoFence(args []string) (geofence.Fence, error) {
+	switch strings.ToUpper(args[0]) {
+	case "CIRCLE":
+		if len(args) != 5 {
+			return nil, errors.New(errSyntax)
+		}
+		lon, lat, err := parseGeoCoordinates(args[1], args[2])
+		if err != nil {
+			return nil, err
+		}
+		radius, _, err := parseGeoDistance("radius", args[3], args[4])
+		if err != nil {
+			return nil, err
+		}
+		return geofence.Circle{Center: geofence.Point{Latitude: lat, Longitude: lon}, RadiusMeters: radius}, nil
+
+	case "POLYGON":
+		coords := args[1:]
+		if len(coords)%2 != 0 {
+			return nil, errors.New(errSyntax)
+		}
+		vertices := make([]geofence.Point, 0, len(coords)/2)
+		for i := 0; i < len(coords); i += 2 {
+			lon, lat, err := parseGeoCoordinates(coords[i], coords[i+1])
+			if err != nil {
+				return nil, err
+			}
+			vertices = append(vertices, geofence.Point{Latitude: lat, Longitude: lon})
+		}
+		polygon, err := geofence.NewPolygon(vertices)
+		if err != nil {
+			return nil, errors.New("ERR " + err.Error())
+		}
+		return polygon, nil
+
+	default:
+		return nil, errors.New(errSyntax)
+	}
+}
+
+// geoFenceMembers replies with the members of key inside the named fence, in geohash order
+// Only the cells around the fence are visited, as in GEOSEARCH.
+func geoFenceMembers(key, name string) RESPValue {
+	fence, exists := geofence.Global.Get(key, name)
+	if !exists {
+		return resp.EncodeSimpleError("ERR no such geofence")
+	}
+
+	zset, ok := lookupSortedSet(key)
+	if !ok {
+		return resp.EncodeSimpleError(errWrongType)
+	}
+	if zset == nil {
+		return resp.EncodeArray([][]byte{})
+	}
+
+	area, center, radius := fence.Bounds()
+	members := [][]byte{}
+	for _, cell := range geohash.SearchCells(center.Latitude, center.Longitude, area, radius) {
+		min, max := cell.ScoreRange()
+		candidates := zset.RangeByScore(
+			store.ScoreBound{Value: float64(min)},
+			store.ScoreBound{Value: float64(max), Exclusive: true},
+			false, 0, -1)
+		for _, item := range candidates {
+			lat, lon := geohash.Decode(uint64(item.Score))
+			if fence.Contains(lat, lon) {
+				members = append(members, resp.EncodeBulkString(item.Member))
+			}
+		}
+	}
+
+	return resp.EncodeArray(members)
+}
+
+// publishGeoFenceCrossings publishes an event on the channel of every fence of key that
+// member entered or left by moving from one score to another
+// from is nil when the member was not on the map before.
+func publishGeoFenceCrossings(key, member string, from *float64, to float64) {
+	var previous *geofence.Point
+	if from != nil {
+		lat, lon := geohash.Decode(uint64(*from))
+		previous = &geofence.Point{Latitude: lat, Longitude: lon}
+	}
+	lat, lon := geohash.Decode(uint64(to))
+
+	for _, crossing := range geofence.Global.Crossings(key, previous, geofence.Point{Latitude: lat, Longitude: lon}) {
+		pubsub.Global.Publish(geofence.Channel(key, crossing.Fence), crossing.Event+" "+member)
+	}
+}
@@ -0,0 +1,42 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/geohash"
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+)
+
+type GeoHashCommand Command
+
+func (cmd *GeoHashCommand) Execute(con *client.Client) RESPValue {
+	// GEOHASH key member [member ...]
+	if len(cmd.args) < 2 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	zset, ok := lookupSortedSet(cmd.args[0])
+	if !ok {
+		return resp.EncodeSimpleError(errWrongType)
+	}
+
+	members := cmd.args[1:]
+	results := make([][]byte, len(members))
+	for i, member := range members {
+		results[i] = resp.EncodeNullBulkString()
+		if zset == nil {
+			continue
+		}
+		score, exists := zset.GetScore(member)
+		if !exists {
+			continue
+		}
+
+		// Re-encode the center of the member's cell rather than its corner, which can sit on a cell edge
+		bounds := geohash.Cell{Bits: uint64(score), Step: geohash.MaxStep}.Bounds()
+		lat := (bounds.MinLat + bounds.MaxLat) / 2
+		lon := (bounds.MinLon + bounds.MaxLon) / 2
+		results[i] = resp.EncodeBulkString(geohash.EncodeBase32(lat, lon))
+	}
+
+	return resp.EncodeArray(results)
+}
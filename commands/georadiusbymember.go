@@ -0,0 +1,18 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+)
+
+type GeoRadiusByMemberCommand Command
+
+func (cmd *GeoRadiusByMemberCommand) Execute(con *client.Client) RESPValue {
+	// GEORADIUSBYMEMBER key member radius m|km|ft|mi [WITHCOORD] [WITHDIST] [WITHHASH]
+	//   [COUNT count [ANY]] [ASC|DESC] [STORE key] [STOREDIST key]
+	if len(cmd.args) < 4 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	return geoRadius(con, cmd.label, cmd.args, geoSearchQuery{member: cmd.args[1]}, cmd.args[2:])
+}
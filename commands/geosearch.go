@@ -0,0 +1,358 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/SuchintK/GoDisKV/geohash"
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+type GeoSearchCommand Command
+
+const (
+	errGeoSearchFrom     = "ERR exactly one of FROMMEMBER or FROMLONLAT can be specified for GEOSEARCH"
+	errGeoSearchBy       = "ERR exactly one of BYRADIUS and BYBOX can be specified for GEOSEARCH"
+	errGeoStoreWith      = "ERR STORE option in GEORADIUS is not compatible with WITHDIST, WITHHASH and WITHCOORDS options"
+	errGeoMemberNotFound = "ERR could not decode requested zset member"
+	errGeoCountRange     = "ERR value is out of range, must be positive"
+)
+
+// Options accepted by parseGeoSearchArgs besides ASC, DESC and COUNT, which every search takes
+const (
+	geoSearchFrom      = 1 << iota // FROMMEMBER, FROMLONLAT, BYRADIUS and BYBOX
+	geoSearchWith                  // WITHCOORD, WITHDIST and WITHHASH
+	geoSearchStore                 // STORE key and STOREDIST key, as GEORADIUS takes them
+	geoSearchStoreDist             // a bare STOREDIST flag, as GEOSEARCHSTORE takes it
+)
+
+// geoSearchQuery describes a GEOSEARCH, GEOSEARCHSTORE or GEORADIUS query
+// Distances are kept in meters; unitFactor converts them back to the unit of the shape
+type geoSearchQuery struct {
+	member     string // FROMMEMBER, resolved to lat/lon when the query runs
+	lat, lon   float64
+	radius     float64
+	width      float64
+	height     float64
+	byBox      bool
+	unitFactor float64
+	asc, desc  bool
+	count      int
+	any        bool
+	withCoord  bool
+	withDist   bool
+	withHash   bool
+	storeKey   string
+	storeDist  bool
+}
+
+type geoSearchResult struct {
+	member   string
+	distance float64 // meters from the center
+	hash     uint64
+	lat      float64
+	lon      float64
+}
+
+func (cmd *GeoSearchCommand) Execute(con *client.Client) RESPValue {
+	// GEOSEARCH key FROMMEMBER member|FROMLONLAT longitude latitude BYRADIUS radius unit|BYBOX width height unit
+	//   [ASC|DESC] [COUNT count [ANY]] [WITHCOORD] [WITHDIST] [WITHHASH]
+	if len(cmd.args) < 1 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	query, err := parseGeoSearchArgs(geoSearchQuery{}, cmd.args[1:], geoSearchFrom|geoSearchWith)
+	if err != nil {
+		return resp.EncodeSimpleError(err.Error())
+	}
+
+	return execGeoSearch(cmd.args[0], query)
+}
+
+// parseGeoSearchArgs parses the options of a geo search into query
+// accepts selects which options besides ASC, DESC and COUNT the command takes
+func parseGeoSearchArgs(query geoSearchQuery, args []string, accepts int) (geoSearchQuery, error) {
+	froms, bys := 0, 0
+
+	for i := 0; i < len(args); i++ {
+		option := strings.ToUpper(args[i])
+		remaining := len(args) - i - 1
+
+		switch {
+		case option == "ASC":
+			query.asc, query.desc = true, false
+		case option == "DESC":
+			query.asc, query.desc = false, true
+		case option == "COUNT" && remaining >= 1:
+			count, err := strconv.Atoi(args[i+1])
+			if err != nil || count <= 0 {
+				return query, errors.New(errGeoCountRange)
+			}
+			query.count = count
+			i++
+			if i+1 < len(args) && strings.ToUpper(args[i+1]) == "ANY" {
+				query.any = true
+				i++
+			}
+		case accepts&geoSearchFrom != 0 && option == "FROMMEMBER" && remaining >= 1:
+			query.member = args[i+1]
+			froms++
+			i++
+		case accepts&geoSearchFrom != 0 && option == "FROMLONLAT" && remaining >= 2:
+			lon, lat, err := parseGeoCoordinates(args[i+1], args[i+2])
+			if err != nil {
+				return query, err
+			}
+			query.lon, query.lat = lon, lat
+			froms++
+			i += 2
+		case accepts&geoSearchFrom != 0 && option == "BYRADIUS" && remaining >= 2:
+			radius, factor, err := parseGeoDistance("radius", args[i+1], args[i+2])
+			if err != nil {
+				return query, err
+			}
+			query.radius, query.byBox, query.unitFactor = radius, false, factor
+			bys++
+			i += 2
+		case accepts&geoSearchFrom != 0 && option == "BYBOX" && remaining >= 3:
+			width, factor, err := parseGeoDistance("width", args[i+1], args[i+3])
+			if err != nil {
+				return query, err
+			}
+			height, _, err := parseGeoDistance("height", args[i+2], args[i+3])
+			if err != nil {
+				return query, err
+			}
+			query.width, query.height, query.byBox, query.unitFactor = width, height, true, factor
+			bys++
+			i += 3
+		case accepts&geoSearchWith != 0 && option == "WITHCOORD":
+			query.withCoord = true
+		case accepts&geoSearchWith != 0 && option == "WITHDIST":
+			query.withDist = true
+		case accepts&geoSearchWith != 0 && option == "WITHHASH":
+			query.withHash = true
+		case accepts&geoSearchStore != 0 && (option == "STORE" || option == "STOREDIST") && remaining >= 1:
+			query.storeKey = args[i+1]
+			query.storeDist = option == "STOREDIST"
+			i++
+		case accepts&geoSearchStoreDist != 0 && option == "STOREDIST":
+			query.storeDist = true
+		default:
+			return query, errors.New(errSyntax)
+		}
+	}
+
+	if accepts&geoSearchFrom != 0 {
+		if froms != 1 {
+			return query, errors.New(errGeoSearchFrom)
+		}
+		if bys != 1 {
+			return query, errors.New(errGeoSearchBy)
+		}
+	}
+	if query.storeKey != "" && (query.withCoord || query.withDist || query.withHash) {
+		return query, errors.New(errGeoStoreWith)
+	}
+
+	return query, nil
+}
+
+// parseGeoCoordinates parses a longitude/latitude pair, rejecting points outside the indexable area
+func parseGeoCoordinates(lonArg, latArg string) (float64, float64, error) {
+	lon, err := strconv.ParseFloat(lonArg, 64)
+	if err != nil {
+		return 0, 0, errors.New(errNotFloat)
+	}
+	lat, err := strconv.ParseFloat(latArg, 64)
+	if err != nil {
+		return 0, 0, errors.New(errNotFloat)
+	}
+	if lon < minLongitude || lon > maxLongitude || lat < minLatitude || lat > maxLatitude {
+		return 0, 0, fmt.Errorf("ERR invalid longitude,latitude pair %f,%f", lon, lat)
+	}
+	return lon, lat, nil
+}
+
+// parseGeoDistance parses the distance called name and its unit, returning the distance in meters
+// along with the factor that converts meters back to the unit
+func parseGeoDistance(name, distanceArg, unitArg string) (float64, float64, error) {
+	distance, err := strconv.ParseFloat(distanceArg, 64)
+	if err != nil || math.IsNaN(distance) || math.IsInf(distance, 0) || distance < 0 {
+		return 0, 0, errors.New("ERR need numeric " + name)
+	}
+	factor, err := parseGeoUnit(unitArg)
+	if err != nil {
+		return 0, 0, err
+	}
+	return distance / factor, factor, nil
+}
+
+// execGeoSearch runs query against the sorted set at key, replying with the matches
+// or, when query has a store key, with the number of members stored there
+func execGeoSearch(key string, query geoSearchQuery) RESPValue {
+	zset, ok := lookupSortedSet(key)
+	if !ok {
+		return resp.EncodeSimpleError(errWrongType)
+	}
+
+	results := []geoSearchResult{}
+	if zset != nil {
+		var err error
+		results, err = query.run(zset)
+		if err != nil {
+			return resp.EncodeSimpleError(err.Error())
+		}
+	}
+
+	if query.storeKey != "" {
+		return storeGeoResults(query, results)
+	}
+	return encodeGeoResults(query, results)
+}
+
+// run returns the members inside the query's shape, sorted and limited as requested
+func (q geoSearchQuery) run(zset *store.SortedSet) ([]geoSearchResult, error) {
+	if q.member != "" {
+		score, exists := zset.GetScore(q.member)
+		if !exists {
+			return nil, errors.New(errGeoMemberNotFound)
+		}
+		q.lat, q.lon = geohash.Decode(uint64(score))
+	}
+
+	results := []geoSearchResult{}
+	for _, item := range q.candidates(zset) {
+		hash := uint64(item.Score)
+		lat, lon := geohash.Decode(hash)
+
+		distance, inside := q.contains(lat, lon)
+		if !inside {
+			continue
+		}
+		results = append(results, geoSearchResult{
+			member:   item.Member,
+			distance: distance,
+			hash:     hash,
+			lat:      lat,
+			lon:      lon,
+		})
+
+		// ANY returns as soon as enough matches are found, whatever their distance
+		if q.any && len(results) == q.count {
+			break
+		}
+	}
+
+	// COUNT without ANY returns the closest matches
+	if q.asc || (q.count > 0 && !q.any && !q.desc) {
+		sort.SliceStable(results, func(i, j int) bool {
+			return results[i].distance < results[j].distance
+		})
+	} else if q.desc {
+		sort.SliceStable(results, func(i, j int) bool {
+			return results[i].distance > results[j].distance
+		})
+	}
+
+	if q.count > 0 && q.count < len(results) {
+		results = results[:q.count]
+	}
+
+	return results, nil
+}
+
+// candidates returns the members stored in the cells around the center that can hold
+// points of the query's shape, seeking each cell's score range instead of scanning the set
+func (q geoSearchQuery) candidates(zset *store.SortedSet) []store.ScoredMember {
+	area := geohash.RadiusArea(q.lat, q.lon, q.radius)
+	radius := q.radius
+	if q.byBox {
+		area = geohash.BoxArea(q.lat, q.lon, q.width, q.height)
+		radius = math.Hypot(q.width, q.height) / 2
+	}
+
+	candidates := []store.ScoredMember{}
+	for _, cell := range geohash.SearchCells(q.lat, q.lon, area, radius) {
+		min, max := cell.ScoreRange()
+		candidates = append(candidates, zset.RangeByScore(
+			store.ScoreBound{Value: float64(min)},
+			store.ScoreBound{Value: float64(max), Exclusive: true},
+			false, 0, -1)...)
+	}
+	return candidates
+}
+
+// contains reports whether the point lies inside the query's shape, and its distance from the center
+func (q geoSearchQuery) contains(lat, lon float64) (float64, bool) {
+	if q.byBox {
+		// Measure north-south along the meridian and east-west along the point's own parallel
+		if geohash.Distance(q.lat, q.lon, lat, q.lon) > q.height/2 {
+			return 0, false
+		}
+		if geohash.Distance(lat, q.lon, lat, lon) > q.width/2 {
+			return 0, false
+		}
+		return geohash.Distance(q.lat, q.lon, lat, lon), true
+	}
+
+	distance := geohash.Distance(q.lat, q.lon, lat, lon)
+	return distance, distance <= q.radius
+}
+
+// encodeGeoResults encodes matches as member names, or as arrays carrying
+// the distance, hash and coordinates when any WITH option is set
+func encodeGeoResults(q geoSearchQuery, results []geoSearchResult) RESPValue {
+	items := make([][]byte, len(results))
+	for i, result := range results {
+		if !q.withCoord && !q.withDist && !q.withHash {
+			items[i] = resp.EncodeBulkString(result.member)
+			continue
+		}
+
+		fields := [][]byte{resp.EncodeBulkString(result.member)}
+		if q.withDist {
+			fields = append(fields, resp.EncodeBulkString(formatFloat(result.distance*q.unitFactor)))
+		}
+		if q.withHash {
+			fields = append(fields, resp.EncodeInteger(int64(result.hash)))
+		}
+		if q.withCoord {
+			fields = append(fields, resp.EncodeArray([][]byte{
+				resp.EncodeBulkString(formatFloat(result.lon)),
+				resp.EncodeBulkString(formatFloat(result.lat)),
+			}))
+		}
+		items[i] = resp.EncodeArray(fields)
+	}
+
+	return resp.EncodeArray(items)
+}
+
+// storeGeoResults stores matches in the query's store key, scored by geohash
+// or, with STOREDIST, by their distance from the center
+func storeGeoResults(q geoSearchQuery, results []geoSearchResult) RESPValue {
+	// An empty result removes the destination, like any emptied sorted set
+	if len(results) == 0 {
+		store.Delete(q.storeKey)
+		return resp.EncodeInteger(0)
+	}
+
+	zset := store.NewSortedSet()
+	for _, result := range results {
+		score := float64(result.hash)
+		if q.storeDist {
+			score = result.distance * q.unitFactor
+		}
+		zset.Add(score, result.member)
+	}
+	store.Set(q.storeKey, &store.Value{SortedSetData: zset})
+
+	return resp.EncodeInteger(int64(len(results)))
+}
@@ -0,0 +1,23 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+)
+
+type GeoSearchStoreCommand Command
+
+func (cmd *GeoSearchStoreCommand) Execute(con *client.Client) RESPValue {
+	// GEOSEARCHSTORE destination source FROMMEMBER member|FROMLONLAT longitude latitude
+	//   BYRADIUS radius unit|BYBOX width height unit [ASC|DESC] [COUNT count [ANY]] [STOREDIST]
+	if len(cmd.args) < 2 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	query, err := parseGeoSearchArgs(geoSearchQuery{storeKey: cmd.args[0]}, cmd.args[2:], geoSearchFrom|geoSearchStoreDist)
+	if err != nil {
+		return resp.EncodeSimpleError(err.Error())
+	}
+
+	return execGeoSearch(cmd.args[1], query)
+}
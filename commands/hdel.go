@@ -0,0 +1,46 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+type HDelCommand Command
+
+func (cmd *HDelCommand) Execute(con *client.Client) RESPValue {
+	// HDEL key field [field ...]
+	if len(cmd.args) < 2 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	key := cmd.args[0]
+	var reply RESPValue
+	store.Atomically(func(tx store.Tx) {
+		hash, ok := lookupHash(tx, key)
+		if !ok {
+			reply = resp.EncodeSimpleError(errWrongType)
+			return
+		}
+		if hash == nil {
+			reply = resp.EncodeInteger(0)
+			return
+		}
+
+		removed := 0
+		for _, field := range cmd.args[1:] {
+			if hash.Delete(field) {
+				removed++
+			}
+		}
+
+		// Remove the key once the hash is empty
+		if hash.Len() == 0 {
+			tx.Delete(key)
+		}
+
+		reply = resp.EncodeInteger(int64(removed))
+	})
+
+	return reply
+}
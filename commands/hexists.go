@@ -0,0 +1,33 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+type HExistsCommand Command
+
+func (cmd *HExistsCommand) Execute(con *client.Client) RESPValue {
+	// HEXISTS key field
+	if len(cmd.args) != 2 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	var reply RESPValue
+	store.Atomically(func(tx store.Tx) {
+		hash, ok := lookupHash(tx, cmd.args[0])
+		if !ok {
+			reply = resp.EncodeSimpleError(errWrongType)
+			return
+		}
+		if hash == nil || !hash.Exists(cmd.args[1]) {
+			reply = resp.EncodeInteger(0)
+			return
+		}
+
+		reply = resp.EncodeInteger(1)
+	})
+
+	return reply
+}
@@ -0,0 +1,162 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+type HExpireCommand Command
+
+// Per-field replies of the hash field expiration commands
+const (
+	fieldNotFound        = -2
+	fieldNoTTL           = -1
+	fieldConditionNotMet = 0
+	fieldTTLUpdated      = 1
+	fieldDeleted         = 2
+)
+
+func (cmd *HExpireCommand) Execute(con *client.Client) RESPValue {
+	return hashExpire(con, cmd.label, cmd.args, time.Second, false)
+}
+
+// hashExpire implements HEXPIRE, HPEXPIRE, HEXPIREAT and HPEXPIREAT
+//
+// <label> key time [NX | XX | GT | LT] FIELDS numfields field [field ...]
+//
+// unit is the resolution of the time argument and absolute tells whether it is
+// a unix timestamp rather than a relative TTL. Replicas always receive HPEXPIREAT
+// with the computed deadline, so they expire fields at the same time as the master.
+func hashExpire(con *client.Client, label string, args []string, unit time.Duration, absolute bool) RESPValue {
+	if len(args) < 5 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	key := args[0]
+	amount, err := strconv.ParseInt(args[1], 10, 64)
+	if err != nil {
+		return resp.EncodeSimpleError(errNotInteger)
+	}
+	if amount < 0 || amount > math.MaxInt64/int64(unit) {
+		return resp.EncodeSimpleError(fmt.Sprintf("ERR invalid expire time in '%s' command", label))
+	}
+
+	var at time.Time
+	if absolute {
+		at = time.Unix(0, 0).Add(time.Duration(amount) * unit)
+	} else {
+		at = time.Now().Add(time.Duration(amount) * unit)
+	}
+
+	// Optional condition precedes the FIELDS block
+	rest := args[2:]
+	condition := ""
+	switch strings.ToUpper(rest[0]) {
+	case "NX", "XX", "GT", "LT":
+		condition = strings.ToUpper(rest[0])
+		rest = rest[1:]
+	}
+
+	fields, err := parseFieldsArg(rest)
+	if err != nil {
+		return resp.EncodeSimpleError(err.Error())
+	}
+
+	var reply RESPValue
+	store.Atomically(func(tx store.Tx) {
+		val, exists := tx.Get(key)
+		if exists && val.HashData == nil {
+			reply = resp.EncodeSimpleError(errWrongType)
+			return
+		}
+
+		propagated := []string{"hpexpireat", key, strconv.FormatInt(at.UnixMilli(), 10)}
+		if condition != "" {
+			propagated = append(propagated, condition)
+		}
+		propagated = append(propagated, "FIELDS", strconv.Itoa(len(fields)))
+		con.Propagate(append(propagated, fields...)...)
+
+		results := make([][]byte, len(fields))
+		for i, field := range fields {
+			if !exists || !val.HashData.Exists(field) {
+				results[i] = resp.EncodeInteger(fieldNotFound)
+				continue
+			}
+
+			hash := val.HashData
+			current, hasTTL := hash.ExpiresAt(field)
+			if !expireConditionMet(condition, hasTTL, current, at) {
+				results[i] = resp.EncodeInteger(fieldConditionNotMet)
+				continue
+			}
+
+			// A time in the past deletes the field right away
+			if !at.After(time.Now()) {
+				hash.Delete(field)
+				results[i] = resp.EncodeInteger(fieldDeleted)
+				continue
+			}
+
+			hash.Expire(field, at)
+			results[i] = resp.EncodeInteger(fieldTTLUpdated)
+		}
+
+		if exists {
+			if val.HashData.Len() == 0 {
+				tx.Delete(key)
+			} else {
+				// Re-store the value so the key is tracked for active expiration
+				tx.Set(key, val)
+			}
+		}
+
+		reply = resp.EncodeArray(results)
+	})
+
+	return reply
+}
+
+// expireConditionMet evaluates NX/XX/GT/LT against the current TTL of a field
+// A field without TTL is treated as having an infinite TTL
+func expireConditionMet(condition string, hasTTL bool, current, at time.Time) bool {
+	switch condition {
+	case "NX":
+		return !hasTTL
+	case "XX":
+		return hasTTL
+	case "GT":
+		return hasTTL && at.After(current)
+	case "LT":
+		return !hasTTL || at.Before(current)
+	default:
+		return true
+	}
+}
+
+// parseFieldsArg parses "FIELDS numfields field [field ...]"
+func parseFieldsArg(args []string) ([]string, error) {
+	if len(args) < 2 || strings.ToUpper(args[0]) != "FIELDS" {
+		return nil, errors.New("ERR Mandatory argument FIELDS is missing or not at the right position")
+	}
+
+	numFields, err := strconv.Atoi(args[1])
+	if err != nil || numFields <= 0 {
+		return nil, errors.New("ERR Parameter `numFields` should be greater than 0")
+	}
+
+	fields := args[2:]
+	if len(fields) != numFields {
+		return nil, errors.New("ERR The `numfields` parameter must match the number of arguments")
+	}
+
+	return fields, nil
+}
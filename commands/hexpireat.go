@@ -0,0 +1,14 @@
+package command
+
+import (
+	"time"
+
+	"github.com/SuchintK/GoDisKV/resp/client"
+)
+
+type HExpireAtCommand Command
+
+func (cmd *HExpireAtCommand) Execute(con *client.Client) RESPValue {
+	// HEXPIREAT key unix-time-seconds [NX | XX | GT | LT] FIELDS numfields field [field ...]
+	return hashExpire(con, cmd.label, cmd.args, time.Second, true)
+}
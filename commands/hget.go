@@ -0,0 +1,39 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+type HGetCommand Command
+
+func (cmd *HGetCommand) Execute(con *client.Client) RESPValue {
+	// HGET key field
+	if len(cmd.args) != 2 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	var reply RESPValue
+	store.Atomically(func(tx store.Tx) {
+		hash, ok := lookupHash(tx, cmd.args[0])
+		if !ok {
+			reply = resp.EncodeSimpleError(errWrongType)
+			return
+		}
+		if hash == nil {
+			reply = resp.EncodeNullBulkString()
+			return
+		}
+
+		value, exists := hash.Get(cmd.args[1])
+		if !exists {
+			reply = resp.EncodeNullBulkString()
+			return
+		}
+
+		reply = resp.EncodeBulkString(value)
+	})
+
+	return reply
+}
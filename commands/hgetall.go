@@ -0,0 +1,42 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+type HGetAllCommand Command
+
+func (cmd *HGetAllCommand) Execute(con *client.Client) RESPValue {
+	// HGETALL key
+	if len(cmd.args) != 1 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	var reply RESPValue
+	store.Atomically(func(tx store.Tx) {
+		hash, ok := lookupHash(tx, cmd.args[0])
+		if !ok {
+			reply = resp.EncodeSimpleError(errWrongType)
+			return
+		}
+		if hash == nil {
+			reply = resp.EncodeArray([][]byte{})
+			return
+		}
+
+		// Flatten as [field1, value1, field2, value2, ...]
+		fields := hash.Fields()
+		results := make([][]byte, 0, len(fields)*2)
+		for _, field := range fields {
+			value, _ := hash.Get(field)
+			results = append(results, resp.EncodeBulkString(field))
+			results = append(results, resp.EncodeBulkString(value))
+		}
+
+		reply = resp.EncodeArray(results)
+	})
+
+	return reply
+}
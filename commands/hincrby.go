@@ -0,0 +1,59 @@
+package command
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+type HIncrByCommand Command
+
+func (cmd *HIncrByCommand) Execute(con *client.Client) RESPValue {
+	// HINCRBY key field increment
+	if len(cmd.args) != 3 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	key := cmd.args[0]
+	field := cmd.args[1]
+
+	increment, err := strconv.ParseInt(cmd.args[2], 10, 64)
+	if err != nil {
+		return resp.EncodeSimpleError(errNotInteger)
+	}
+
+	var reply RESPValue
+	store.Atomically(func(tx store.Tx) {
+		hash, ok := getOrCreateHash(tx, key)
+		if !ok {
+			reply = resp.EncodeSimpleError(errWrongType)
+			return
+		}
+
+		var current int64 = 0
+		if value, exists := hash.Get(field); exists {
+			current, err = strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				reply = resp.EncodeSimpleError("ERR hash value is not an integer")
+				return
+			}
+		}
+
+		// Detect signed overflow before applying the increment
+		if (increment > 0 && current > math.MaxInt64-increment) ||
+			(increment < 0 && current < math.MinInt64-increment) {
+			reply = resp.EncodeSimpleError("ERR increment or decrement would overflow")
+			return
+		}
+
+		current += increment
+		hash.Update(field, strconv.FormatInt(current, 10))
+
+		reply = resp.EncodeInteger(current)
+	})
+
+	return reply
+}
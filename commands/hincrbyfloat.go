@@ -0,0 +1,58 @@
+package command
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+type HIncrByFloatCommand Command
+
+func (cmd *HIncrByFloatCommand) Execute(con *client.Client) RESPValue {
+	// HINCRBYFLOAT key field increment
+	if len(cmd.args) != 3 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	key := cmd.args[0]
+	field := cmd.args[1]
+
+	increment, err := strconv.ParseFloat(cmd.args[2], 64)
+	if err != nil || math.IsNaN(increment) || math.IsInf(increment, 0) {
+		return resp.EncodeSimpleError(errNotFloat)
+	}
+
+	var reply RESPValue
+	store.Atomically(func(tx store.Tx) {
+		hash, ok := getOrCreateHash(tx, key)
+		if !ok {
+			reply = resp.EncodeSimpleError(errWrongType)
+			return
+		}
+
+		var current float64 = 0
+		if value, exists := hash.Get(field); exists {
+			current, err = strconv.ParseFloat(value, 64)
+			if err != nil {
+				reply = resp.EncodeSimpleError("ERR hash value is not a float")
+				return
+			}
+		}
+
+		current += increment
+		if math.IsNaN(current) || math.IsInf(current, 0) {
+			reply = resp.EncodeSimpleError("ERR increment would produce NaN or Infinity")
+			return
+		}
+
+		result := strconv.FormatFloat(current, 'f', -1, 64)
+		hash.Update(field, result)
+
+		reply = resp.EncodeBulkString(result)
+	})
+
+	return reply
+}
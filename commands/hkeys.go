@@ -0,0 +1,33 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+type HKeysCommand Command
+
+func (cmd *HKeysCommand) Execute(con *client.Client) RESPValue {
+	// HKEYS key
+	if len(cmd.args) != 1 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	var reply RESPValue
+	store.Atomically(func(tx store.Tx) {
+		hash, ok := lookupHash(tx, cmd.args[0])
+		if !ok {
+			reply = resp.EncodeSimpleError(errWrongType)
+			return
+		}
+		if hash == nil {
+			reply = resp.EncodeArray([][]byte{})
+			return
+		}
+
+		reply = resp.EncodeArrayBulk(hash.Fields()...)
+	})
+
+	return reply
+}
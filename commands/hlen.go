@@ -0,0 +1,33 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+type HLenCommand Command
+
+func (cmd *HLenCommand) Execute(con *client.Client) RESPValue {
+	// HLEN key
+	if len(cmd.args) != 1 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	var reply RESPValue
+	store.Atomically(func(tx store.Tx) {
+		hash, ok := lookupHash(tx, cmd.args[0])
+		if !ok {
+			reply = resp.EncodeSimpleError(errWrongType)
+			return
+		}
+		if hash == nil {
+			reply = resp.EncodeInteger(0)
+			return
+		}
+
+		reply = resp.EncodeInteger(int64(hash.Len()))
+	})
+
+	return reply
+}
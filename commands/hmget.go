@@ -0,0 +1,44 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+type HMGetCommand Command
+
+func (cmd *HMGetCommand) Execute(con *client.Client) RESPValue {
+	// HMGET key field [field ...]
+	if len(cmd.args) < 2 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	var reply RESPValue
+	store.Atomically(func(tx store.Tx) {
+		hash, ok := lookupHash(tx, cmd.args[0])
+		if !ok {
+			reply = resp.EncodeSimpleError(errWrongType)
+			return
+		}
+
+		fields := cmd.args[1:]
+		results := make([][]byte, len(fields))
+		for i, field := range fields {
+			if hash == nil {
+				results[i] = resp.EncodeNullBulkString()
+				continue
+			}
+			value, exists := hash.Get(field)
+			if !exists {
+				results[i] = resp.EncodeNullBulkString()
+				continue
+			}
+			results[i] = resp.EncodeBulkString(value)
+		}
+
+		reply = resp.EncodeArray(results)
+	})
+
+	return reply
+}
@@ -0,0 +1,47 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+type HPersistCommand Command
+
+func (cmd *HPersistCommand) Execute(con *client.Client) RESPValue {
+	// HPERSIST key FIELDS numfields field [field ...]
+	if len(cmd.args) < 3 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	fields, err := parseFieldsArg(cmd.args[1:])
+	if err != nil {
+		return resp.EncodeSimpleError(err.Error())
+	}
+
+	var reply RESPValue
+	store.Atomically(func(tx store.Tx) {
+		hash, ok := lookupHash(tx, cmd.args[0])
+		if !ok {
+			reply = resp.EncodeSimpleError(errWrongType)
+			return
+		}
+
+		results := make([][]byte, len(fields))
+		for i, field := range fields {
+			if hash == nil || !hash.Exists(field) {
+				results[i] = resp.EncodeInteger(fieldNotFound)
+				continue
+			}
+			if !hash.Persist(field) {
+				results[i] = resp.EncodeInteger(fieldNoTTL)
+				continue
+			}
+			results[i] = resp.EncodeInteger(fieldTTLUpdated)
+		}
+
+		reply = resp.EncodeArray(results)
+	})
+
+	return reply
+}
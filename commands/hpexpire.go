@@ -0,0 +1,14 @@
+package command
+
+import (
+	"time"
+
+	"github.com/SuchintK/GoDisKV/resp/client"
+)
+
+type HPExpireCommand Command
+
+func (cmd *HPExpireCommand) Execute(con *client.Client) RESPValue {
+	// HPEXPIRE key milliseconds [NX | XX | GT | LT] FIELDS numfields field [field ...]
+	return hashExpire(con, cmd.label, cmd.args, time.Millisecond, false)
+}
@@ -0,0 +1,14 @@
+package command
+
+import (
+	"time"
+
+	"github.com/SuchintK/GoDisKV/resp/client"
+)
+
+type HPExpireAtCommand Command
+
+func (cmd *HPExpireAtCommand) Execute(con *client.Client) RESPValue {
+	// HPEXPIREAT key unix-time-milliseconds [NX | XX | GT | LT] FIELDS numfields field [field ...]
+	return hashExpire(con, cmd.label, cmd.args, time.Millisecond, true)
+}
@@ -0,0 +1,14 @@
+package command
+
+import (
+	"time"
+
+	"github.com/SuchintK/GoDisKV/resp/client"
+)
+
+type HPTTLCommand Command
+
+func (cmd *HPTTLCommand) Execute(con *client.Client) RESPValue {
+	// HPTTL key FIELDS numfields field [field ...]
+	return hashTTL(cmd.args, time.Millisecond)
+}
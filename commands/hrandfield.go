@@ -0,0 +1,108 @@
+package command
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"strconv"
+	"strings"
+
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+type HRandFieldCommand Command
+
+func (cmd *HRandFieldCommand) Execute(con *client.Client) RESPValue {
+	// HRANDFIELD key [count [WITHVALUES]]
+	numArgs := len(cmd.args)
+	if numArgs < 1 || numArgs > 3 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	var reply RESPValue
+	store.Atomically(func(tx store.Tx) {
+		hash, ok := lookupHash(tx, cmd.args[0])
+		if !ok {
+			reply = resp.EncodeSimpleError(errWrongType)
+			return
+		}
+
+		// Without count a single field (or nil) is returned
+		if numArgs == 1 {
+			if hash == nil {
+				reply = resp.EncodeNullBulkString()
+				return
+			}
+			fields := hash.Fields()
+			reply = resp.EncodeBulkString(fields[rand.Intn(len(fields))])
+			return
+		}
+
+		count, err := parseRandomCount(cmd.args[1])
+		if err != nil {
+			reply = resp.EncodeSimpleError(err.Error())
+			return
+		}
+
+		withValues := false
+		if numArgs == 3 {
+			if strings.ToUpper(cmd.args[2]) != "WITHVALUES" {
+				reply = resp.EncodeSimpleError(errSyntax)
+				return
+			}
+			withValues = true
+		}
+
+		if hash == nil || count == 0 {
+			reply = resp.EncodeArray([][]byte{})
+			return
+		}
+
+		fields := hash.Fields()
+		var picked []string
+		if count > 0 {
+			// Positive count: distinct fields, at most the size of the hash
+			rand.Shuffle(len(fields), func(i, j int) {
+				fields[i], fields[j] = fields[j], fields[i]
+			})
+			if count < len(fields) {
+				fields = fields[:count]
+			}
+			picked = fields
+		} else {
+			// Negative count: the same field may be returned multiple times
+			picked = make([]string, -count)
+			for i := range picked {
+				picked[i] = fields[rand.Intn(len(fields))]
+			}
+		}
+
+		results := make([][]byte, 0, len(picked)*2)
+		for _, field := range picked {
+			results = append(results, resp.EncodeBulkString(field))
+			if withValues {
+				value, _ := hash.Get(field)
+				results = append(results, resp.EncodeBulkString(value))
+			}
+		}
+
+		reply = resp.EncodeArray(results)
+	})
+
+	return reply
+}
+
+// parseRandomCount parses the count of a random member command
+// Counts beyond half the integer range are rejected, so that negating one never overflows.
+func parseRandomCount(arg string) (int, error) {
+	count, err := strconv.Atoi(arg)
+	if err != nil {
+		return 0, errors.New(errNotInteger)
+	}
+	if count < -math.MaxInt/2 || count > math.MaxInt/2 {
+		return 0, errors.New("ERR value is out of range")
+	}
+	return count, nil
+}
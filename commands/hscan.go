@@ -0,0 +1,129 @@
+package command
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/SuchintK/GoDisKV/glob"
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+type HScanCommand Command
+
+// scanOptions holds the arguments shared by the *SCAN family of commands
+type scanOptions struct {
+	cursor   uint64
+	count    int
+	pattern  string
+	noValues bool
+}
+
+func (cmd *HScanCommand) Execute(con *client.Client) RESPValue {
+	// HSCAN key cursor [MATCH pattern] [COUNT count] [NOVALUES]
+	if len(cmd.args) < 2 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	opts, err := parseScanOptions(cmd.args[1:], true)
+	if err != nil {
+		return resp.EncodeSimpleError(err.Error())
+	}
+
+	var reply RESPValue
+	store.Atomically(func(tx store.Tx) {
+		hash, ok := lookupHash(tx, cmd.args[0])
+		if !ok {
+			reply = resp.EncodeSimpleError(errWrongType)
+			return
+		}
+		if hash == nil {
+			reply = encodeScanReply(0, [][]byte{})
+			return
+		}
+
+		next, fields := scanOrdered(hash.ScanOrder(), opts)
+
+		items := make([][]byte, 0, len(fields)*2)
+		for _, field := range fields {
+			items = append(items, resp.EncodeBulkString(field))
+			if !opts.noValues {
+				value, _ := hash.Get(field)
+				items = append(items, resp.EncodeBulkString(value))
+			}
+		}
+
+		reply = encodeScanReply(next, items)
+	})
+
+	return reply
+}
+
+// parseScanOptions parses "cursor [MATCH pattern] [COUNT count]"
+// NOVALUES is only accepted when allowNoValues is set
+func parseScanOptions(args []string, allowNoValues bool) (scanOptions, error) {
+	opts := scanOptions{count: 10, pattern: "*"}
+
+	cursor, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return opts, errors.New("ERR invalid cursor")
+	}
+	opts.cursor = cursor
+
+	for i := 1; i < len(args); i++ {
+		switch strings.ToUpper(args[i]) {
+		case "MATCH":
+			if i+1 >= len(args) {
+				return opts, errors.New(errSyntax)
+			}
+			i++
+			opts.pattern = args[i]
+		case "COUNT":
+			if i+1 >= len(args) {
+				return opts, errors.New(errSyntax)
+			}
+			i++
+			count, err := strconv.Atoi(args[i])
+			if err != nil {
+				return opts, errors.New(errNotInteger)
+			}
+			if count < 1 {
+				return opts, errors.New(errSyntax)
+			}
+			opts.count = count
+		case "NOVALUES":
+			if !allowNoValues {
+				return opts, errors.New(errSyntax)
+			}
+			opts.noValues = true
+		default:
+			return opts, errors.New(errSyntax)
+		}
+	}
+
+	return opts, nil
+}
+
+// scanOrdered walks up to opts.count elements of the scan order starting at the cursor
+// and returns the matching ones together with the cursor for the next call (0 when done)
+func scanOrdered(order *store.ScanOrder, opts scanOptions) (uint64, []string) {
+	elements, next := order.Scan(opts.cursor, opts.count)
+
+	matched := make([]string, 0, len(elements))
+	for _, element := range elements {
+		if glob.Match(opts.pattern, element) {
+			matched = append(matched, element)
+		}
+	}
+	return next, matched
+}
+
+// encodeScanReply encodes the [cursor, [items...]] reply of the *SCAN commands
+func encodeScanReply(cursor uint64, items [][]byte) []byte {
+	return resp.EncodeArray([][]byte{
+		resp.EncodeBulkString(strconv.FormatUint(cursor, 10)),
+		resp.EncodeArray(items),
+	})
+}
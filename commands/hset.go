@@ -0,0 +1,66 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+type HSetCommand Command
+
+func (cmd *HSetCommand) Execute(con *client.Client) RESPValue {
+	numArgs := len(cmd.args)
+
+	// HSET key field value [field value ...]
+	if numArgs < 3 || (numArgs-1)%2 != 0 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	key := cmd.args[0]
+	var reply RESPValue
+	store.Atomically(func(tx store.Tx) {
+		hash, ok := getOrCreateHash(tx, key)
+		if !ok {
+			reply = resp.EncodeSimpleError(errWrongType)
+			return
+		}
+
+		added := 0
+		for i := 1; i < numArgs; i += 2 {
+			if hash.Set(cmd.args[i], cmd.args[i+1]) {
+				added++
+			}
+		}
+
+		reply = resp.EncodeInteger(int64(added))
+	})
+
+	return reply
+}
+
+// lookupHash returns the hash stored at key, or nil if the key does not exist
+// ok is false when the key holds a value of another type
+func lookupHash(tx store.Tx, key string) (*store.Hash, bool) {
+	val, exists := tx.Get(key)
+	if !exists {
+		return nil, true
+	}
+	if val.HashData == nil {
+		return nil, false
+	}
+	return val.HashData, true
+}
+
+// getOrCreateHash returns the hash stored at key, creating an empty one if needed
+// ok is false when the key holds a value of another type
+func getOrCreateHash(tx store.Tx, key string) (*store.Hash, bool) {
+	hash, ok := lookupHash(tx, key)
+	if !ok {
+		return nil, false
+	}
+	if hash == nil {
+		hash = store.NewHash()
+		tx.Set(key, &store.Value{HashData: hash})
+	}
+	return hash, true
+}
@@ -0,0 +1,38 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+type HSetNXCommand Command
+
+func (cmd *HSetNXCommand) Execute(con *client.Client) RESPValue {
+	// HSETNX key field value
+	if len(cmd.args) != 3 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	key := cmd.args[0]
+	field := cmd.args[1]
+
+	var reply RESPValue
+	store.Atomically(func(tx store.Tx) {
+		hash, ok := getOrCreateHash(tx, key)
+		if !ok {
+			reply = resp.EncodeSimpleError(errWrongType)
+			return
+		}
+
+		if hash.Exists(field) {
+			reply = resp.EncodeInteger(0)
+			return
+		}
+
+		hash.Set(field, cmd.args[2])
+		reply = resp.EncodeInteger(1)
+	})
+
+	return reply
+}
@@ -0,0 +1,34 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+type HStrLenCommand Command
+
+func (cmd *HStrLenCommand) Execute(con *client.Client) RESPValue {
+	// HSTRLEN key field
+	if len(cmd.args) != 2 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	var reply RESPValue
+	store.Atomically(func(tx store.Tx) {
+		hash, ok := lookupHash(tx, cmd.args[0])
+		if !ok {
+			reply = resp.EncodeSimpleError(errWrongType)
+			return
+		}
+		if hash == nil {
+			reply = resp.EncodeInteger(0)
+			return
+		}
+
+		value, _ := hash.Get(cmd.args[1])
+		reply = resp.EncodeInteger(int64(len(value)))
+	})
+
+	return reply
+}
@@ -0,0 +1,60 @@
+package command
+
+import (
+	"time"
+
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+type HTTLCommand Command
+
+func (cmd *HTTLCommand) Execute(con *client.Client) RESPValue {
+	// HTTL key FIELDS numfields field [field ...]
+	return hashTTL(cmd.args, time.Second)
+}
+
+// hashTTL implements HTTL and HPTTL, reporting the remaining TTL of each field in unit
+func hashTTL(args []string, unit time.Duration) RESPValue {
+	if len(args) < 3 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	fields, err := parseFieldsArg(args[1:])
+	if err != nil {
+		return resp.EncodeSimpleError(err.Error())
+	}
+
+	var reply RESPValue
+	store.Atomically(func(tx store.Tx) {
+		hash, ok := lookupHash(tx, args[0])
+		if !ok {
+			reply = resp.EncodeSimpleError(errWrongType)
+			return
+		}
+
+		now := time.Now()
+		results := make([][]byte, len(fields))
+		for i, field := range fields {
+			if hash == nil || !hash.Exists(field) {
+				results[i] = resp.EncodeInteger(fieldNotFound)
+				continue
+			}
+
+			at, hasTTL := hash.ExpiresAt(field)
+			if !hasTTL {
+				results[i] = resp.EncodeInteger(fieldNoTTL)
+				continue
+			}
+
+			// Round up so a field never reports 0 while it still exists
+			remaining := at.Sub(now)
+			results[i] = resp.EncodeInteger(int64((remaining + unit - 1) / unit))
+		}
+
+		reply = resp.EncodeArray(results)
+	})
+
+	return reply
+}
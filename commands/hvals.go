@@ -0,0 +1,39 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+type HValsCommand Command
+
+func (cmd *HValsCommand) Execute(con *client.Client) RESPValue {
+	// HVALS key
+	if len(cmd.args) != 1 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	var reply RESPValue
+	store.Atomically(func(tx store.Tx) {
+		hash, ok := lookupHash(tx, cmd.args[0])
+		if !ok {
+			reply = resp.EncodeSimpleError(errWrongType)
+			return
+		}
+		if hash == nil {
+			reply = resp.EncodeArray([][]byte{})
+			return
+		}
+
+		fields := hash.Fields()
+		values := make([]string, len(fields))
+		for i, field := range fields {
+			values[i], _ = hash.Get(field)
+		}
+
+		reply = resp.EncodeArrayBulk(values...)
+	})
+
+	return reply
+}
@@ -0,0 +1,60 @@
+package command
+
+import (
+	"strconv"
+
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+type LIndexCommand Command
+
+func (cmd *LIndexCommand) Execute(con *client.Client) RESPValue {
+	// LINDEX key index
+	if len(cmd.args) != 2 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	index, err := strconv.Atoi(cmd.args[1])
+	if err != nil {
+		return resp.EncodeSimpleError(errNotInteger)
+	}
+
+	val, ok := lookupList(cmd.args[0])
+	if !ok {
+		return resp.EncodeSimpleError(errWrongType)
+	}
+	if val == nil {
+		return resp.EncodeNullBulkString()
+	}
+
+	index, inRange := normalizeListIndex(index, val.ListData.Len())
+	if !inRange {
+		return resp.EncodeNullBulkString()
+	}
+
+	return resp.EncodeBulkString(val.ListData.Index(index))
+}
+
+// lookupList returns the value holding the list stored at key, or nil if the key does not exist
+// ok is false when the key holds a value of another type
+func lookupList(key string) (*store.Value, bool) {
+	val, exists := store.Get(key)
+	if !exists {
+		return nil, true
+	}
+	if val.ListData == nil {
+		return nil, false
+	}
+	return val, true
+}
+
+// normalizeListIndex converts a possibly negative index into an offset from the head
+// and reports whether it falls inside a list of the given length
+func normalizeListIndex(index, length int) (int, bool) {
+	if index < 0 {
+		index = length + index
+	}
+	return index, index >= 0 && index < length
+}
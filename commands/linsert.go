@@ -0,0 +1,57 @@
+package command
+
+import (
+	"strings"
+
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+)
+
+type LInsertCommand Command
+
+func (cmd *LInsertCommand) Execute(con *client.Client) RESPValue {
+	// LINSERT key BEFORE|AFTER pivot element
+	if len(cmd.args) != 4 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	var after bool
+	switch strings.ToUpper(cmd.args[1]) {
+	case "BEFORE":
+		after = false
+	case "AFTER":
+		after = true
+	default:
+		return resp.EncodeSimpleError(errSyntax)
+	}
+
+	pivot := cmd.args[2]
+	element := cmd.args[3]
+
+	val, ok := lookupList(cmd.args[0])
+	if !ok {
+		return resp.EncodeSimpleError(errWrongType)
+	}
+	if val == nil {
+		return resp.EncodeInteger(0)
+	}
+
+	pos := -1
+	val.ListData.Iterate(false, func(i int, item string) bool {
+		if item == pivot {
+			pos = i
+			return false
+		}
+		return true
+	})
+	if pos == -1 {
+		return resp.EncodeInteger(-1)
+	}
+	if after {
+		pos++
+	}
+
+	val.ListData.Insert(pos, element)
+
+	return resp.EncodeInteger(int64(val.ListData.Len()))
+}
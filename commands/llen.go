@@ -26,5 +26,5 @@ func (cmd *LLenCommand) Execute(con *client.Client) RESPValue {
 		return resp.EncodeSimpleError(errWrongType)
 	}
 
-	return resp.EncodeInteger(int64(len(val.ListData)))
+	return resp.EncodeInteger(int64(val.ListData.Len()))
 }
@@ -0,0 +1,110 @@
+package command
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+type LMoveCommand Command
+
+var errListWrongType = errors.New(errWrongType)
+
+func (cmd *LMoveCommand) Execute(con *client.Client) RESPValue {
+	// LMOVE source destination LEFT|RIGHT LEFT|RIGHT
+	if len(cmd.args) != 4 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	fromLeft, ok := parseListDirection(cmd.args[2])
+	if !ok {
+		return resp.EncodeSimpleError(errSyntax)
+	}
+	toLeft, ok := parseListDirection(cmd.args[3])
+	if !ok {
+		return resp.EncodeSimpleError(errSyntax)
+	}
+
+	element, moved, err := listMove(cmd.args[0], cmd.args[1], fromLeft, toLeft)
+	if err != nil {
+		return resp.EncodeSimpleError(err.Error())
+	}
+	if !moved {
+		return resp.EncodeNullBulkString()
+	}
+
+	return resp.EncodeBulkString(element)
+}
+
+// parseListDirection parses LEFT or RIGHT, returning true for LEFT
+func parseListDirection(s string) (bool, bool) {
+	switch strings.ToUpper(s) {
+	case "LEFT":
+		return true, true
+	case "RIGHT":
+		return false, true
+	default:
+		return false, false
+	}
+}
+
+// listDirection is the inverse of parseListDirection, used when rewriting commands for replicas
+func listDirection(left bool) string {
+	if left {
+		return "left"
+	}
+	return "right"
+}
+
+// listMove pops an element from one end of source and pushes it to one end of destination
+// The whole move happens under the store lock, so no other client can observe the element
+// in neither or both lists. moved is false when source does not exist.
+func listMove(source, destination string, fromLeft, toLeft bool) (element string, moved bool, err error) {
+	store.Atomically(func(tx store.Tx) {
+		src, exists := tx.Get(source)
+		if !exists {
+			return
+		}
+		if src.ListData == nil {
+			err = errListWrongType
+			return
+		}
+
+		// Check the destination type before touching the source
+		dst, dstExists := tx.Get(destination)
+		if dstExists && dst.ListData == nil {
+			err = errListWrongType
+			return
+		}
+
+		if fromLeft {
+			element, _ = src.ListData.PopLeft()
+		} else {
+			element, _ = src.ListData.PopRight()
+		}
+		moved = true
+
+		if src.ListData.Len() == 0 {
+			tx.Delete(source)
+			if source == destination {
+				dstExists = false
+			}
+		}
+
+		if !dstExists {
+			dst = &store.Value{ListData: store.NewList()}
+			tx.Set(destination, dst)
+		}
+
+		if toLeft {
+			dst.ListData.PushLeft(element)
+		} else {
+			dst.ListData.PushRight(element)
+		}
+	})
+
+	return element, moved, err
+}
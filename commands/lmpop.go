@@ -0,0 +1,94 @@
+package command
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+)
+
+type LMPopCommand Command
+
+// multiPopRequest holds the parsed arguments shared by LMPOP, ZMPOP and their blocking forms
+type multiPopRequest struct {
+	keys     []string
+	fromHead bool // LEFT for lists, MIN for sorted sets
+	count    int
+}
+
+func (cmd *LMPopCommand) Execute(con *client.Client) RESPValue {
+	// LMPOP numkeys key [key ...] LEFT|RIGHT [COUNT count]
+	req, err := parseMultiPopArgs(cmd.args, parseListDirection)
+	if err != nil {
+		return resp.EncodeSimpleError(err.Error())
+	}
+
+	for _, key := range req.keys {
+		elements, ok := listPopCount(key, req.fromHead, req.count)
+		if !ok {
+			return resp.EncodeSimpleError(errWrongType)
+		}
+		if len(elements) > 0 {
+			return encodeMultiPopReply(key, elements)
+		}
+	}
+
+	return resp.EncodeNullArray()
+}
+
+// parseMultiPopArgs parses "numkeys key [key ...] <where> [COUNT count]", where the
+// direction is parsed by parseWhere: LEFT|RIGHT for lists, MIN|MAX for sorted sets
+func parseMultiPopArgs(args []string, parseWhere func(string) (bool, bool)) (multiPopRequest, error) {
+	var req multiPopRequest
+	if len(args) < 3 {
+		return req, errors.New(errWrongNumberOfArgs)
+	}
+
+	numKeys, err := strconv.Atoi(args[0])
+	if err != nil {
+		return req, errors.New(errNotInteger)
+	}
+	if numKeys <= 0 {
+		return req, errors.New("ERR numkeys should be greater than 0")
+	}
+	// numkeys keys followed by at least the direction, compared without adding to numkeys
+	if numKeys > len(args)-2 {
+		return req, errors.New(errSyntax)
+	}
+	req.keys = args[1 : 1+numKeys]
+
+	var ok bool
+	req.fromHead, ok = parseWhere(args[1+numKeys])
+	if !ok {
+		return req, errors.New(errSyntax)
+	}
+
+	req.count = 1
+	rest := args[2+numKeys:]
+	switch {
+	case len(rest) == 0:
+	case len(rest) == 2 && strings.ToUpper(rest[0]) == "COUNT":
+		count, err := strconv.Atoi(rest[1])
+		if err != nil {
+			return req, errors.New(errNotInteger)
+		}
+		if count <= 0 {
+			return req, errors.New("ERR count should be greater than 0")
+		}
+		req.count = count
+	default:
+		return req, errors.New(errSyntax)
+	}
+
+	return req, nil
+}
+
+// encodeMultiPopReply encodes the [key, [element ...]] reply of LMPOP and BLMPOP
+func encodeMultiPopReply(key string, elements []string) RESPValue {
+	return resp.EncodeArray([][]byte{
+		resp.EncodeBulkString(key),
+		resp.EncodeArrayBulk(elements...),
+	})
+}
@@ -14,33 +14,58 @@ func (cmd *LPopCommand) Execute(con *client.Client) RESPValue {
 	}
 
 	key := cmd.args[0]
-	val, exists := store.Get(key)
 
-	// Key doesn't exist
-	if !exists || val.ListData == nil {
-		return resp.EncodeNullBulkString()
-	}
-
-	// Check if it's not a list
-	if val.ListData == nil {
+	elements, ok := listPopCount(key, true, 1)
+	if !ok {
 		return resp.EncodeSimpleError(errWrongType)
 	}
-
-	// Empty list
-	if len(val.ListData) == 0 {
+	if len(elements) == 0 {
 		return resp.EncodeNullBulkString()
 	}
 
-	// Pop the first element
-	element := val.ListData[0]
-	val.ListData = val.ListData[1:]
+	return resp.EncodeBulkString(elements[0])
+}
 
-	// Update or delete the key
-	if len(val.ListData) == 0 {
-		store.Delete(key)
-	} else {
-		store.Set(key, val)
-	}
+// listPopCount atomically removes up to count elements from the head (or tail) of the list
+// at key, in pop order, deleting the key once the list is empty
+// ok is false when the key holds a value of another type.
+func listPopCount(key string, fromLeft bool, count int) (elements []string, ok bool) {
+	ok = true
+	store.Atomically(func(tx store.Tx) {
+		val, exists := tx.Get(key)
+		if !exists {
+			return
+		}
+		if val.ListData == nil {
+			ok = false
+			return
+		}
+		if val.ListData.Len() == 0 {
+			return
+		}
+
+		if count > val.ListData.Len() {
+			count = val.ListData.Len()
+		}
+		elements = make([]string, count)
+
+		for i := range elements {
+			if fromLeft {
+				// Pop from the head
+				elements[i], _ = val.ListData.PopLeft()
+			} else {
+				// Pop from the tail, last element first
+				elements[i], _ = val.ListData.PopRight()
+			}
+		}
+
+		// Update or delete the key
+		if val.ListData.Len() == 0 {
+			tx.Delete(key)
+		} else {
+			tx.Set(key, val)
+		}
+	})
 
-	return resp.EncodeBulkString(element)
+	return elements, ok
 }
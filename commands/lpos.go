@@ -0,0 +1,111 @@
+package command
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+type LPosCommand Command
+
+func (cmd *LPosCommand) Execute(con *client.Client) RESPValue {
+	// LPOS key element [RANK rank] [COUNT num-matches] [MAXLEN len]
+	if len(cmd.args) < 2 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	element := cmd.args[1]
+	rank := 1
+	count := -1 // -1 means COUNT was not given
+	maxLen := 0
+
+	for i := 2; i < len(cmd.args); i++ {
+		option := strings.ToUpper(cmd.args[i])
+		if (option != "RANK" && option != "COUNT" && option != "MAXLEN") || i+1 >= len(cmd.args) {
+			return resp.EncodeSimpleError(errSyntax)
+		}
+		i++
+		n, err := strconv.Atoi(cmd.args[i])
+		if err != nil {
+			return resp.EncodeSimpleError(errNotInteger)
+		}
+
+		switch option {
+		case "RANK":
+			if n == 0 {
+				return resp.EncodeSimpleError("ERR RANK can't be zero: use 1 to start from the first match, 2 from the second ... or use negative to start from the end of the list")
+			}
+			rank = n
+		case "COUNT":
+			if n < 0 {
+				return resp.EncodeSimpleError("ERR COUNT can't be negative")
+			}
+			count = n
+		case "MAXLEN":
+			if n < 0 {
+				return resp.EncodeSimpleError("ERR MAXLEN can't be negative")
+			}
+			maxLen = n
+		}
+	}
+
+	val, ok := lookupList(cmd.args[0])
+	if !ok {
+		return resp.EncodeSimpleError(errWrongType)
+	}
+
+	var matches []int
+	if val != nil {
+		matches = findListPositions(val.ListData, element, rank, count, maxLen)
+	}
+
+	if count == -1 {
+		if len(matches) == 0 {
+			return resp.EncodeNullBulkString()
+		}
+		return resp.EncodeInteger(int64(matches[0]))
+	}
+
+	results := make([][]byte, len(matches))
+	for i, pos := range matches {
+		results[i] = resp.EncodeInteger(int64(pos))
+	}
+	return resp.EncodeArray(results)
+}
+
+// findListPositions returns the indexes of element, skipping the first |rank|-1 matches
+// A negative rank scans from the tail, count 0 returns every match, count -1 only the first,
+// and maxLen > 0 limits how many items are compared
+func findListPositions(list *store.List, element string, rank, count, maxLen int) []int {
+	wanted := count
+	if wanted == -1 {
+		wanted = 1
+	}
+
+	skip := rank - 1
+	if rank < 0 {
+		skip = -rank - 1
+	}
+
+	matches := []int{}
+	compared := 0
+	list.Iterate(rank < 0, func(pos int, item string) bool {
+		if maxLen > 0 && compared >= maxLen {
+			return false
+		}
+		compared++
+		if item != element {
+			return true
+		}
+		if skip > 0 {
+			skip--
+			return true
+		}
+		matches = append(matches, pos)
+		return wanted == 0 || len(matches) < wanted
+	})
+	return matches
+}
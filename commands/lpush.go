@@ -16,29 +16,32 @@ func (cmd *LPushCommand) Execute(con *client.Client) RESPValue {
 	key := cmd.args[0]
 	elements := cmd.args[1:]
 
-	val, exists := store.Get(key)
+	var result RESPValue
+	store.Atomically(func(tx store.Tx) {
+		val, exists := tx.Get(key)
+
+		// Check if key exists and is not a list
+		if exists && val.ListData == nil {
+			result = resp.EncodeSimpleError(errWrongType)
+			return
+		}
 
-	// Check if key exists and is not a list
-	if exists && val.ListData == nil && val.Data != "" {
-		return resp.EncodeSimpleError(errWrongType)
-	}
+		// Initialize list if it doesn't exist
+		if !exists || val.ListData == nil {
+			val = &store.Value{
+				ListData: store.NewList(),
+			}
+		}
 
-	// Initialize list if it doesn't exist
-	if !exists || val.ListData == nil {
-		val = &store.Value{
-			ListData: []string{},
+		// Push elements to the head one by one, so the last one ends up first
+		for _, element := range elements {
+			val.ListData.PushLeft(element)
 		}
-	}
 
-	// Push elements to the head (prepend in reverse order to maintain order)
-	newList := make([]string, 0, len(val.ListData)+len(elements))
-	for i := len(elements) - 1; i >= 0; i-- {
-		newList = append(newList, elements[i])
-	}
-	newList = append(newList, val.ListData...)
-	val.ListData = newList
+		tx.Set(key, val)
 
-	store.Set(key, val)
+		result = resp.EncodeInteger(int64(val.ListData.Len()))
+	})
 
-	return resp.EncodeInteger(int64(len(val.ListData)))
+	return result
 }
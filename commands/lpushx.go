@@ -0,0 +1,31 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+)
+
+type LPushXCommand Command
+
+func (cmd *LPushXCommand) Execute(con *client.Client) RESPValue {
+	// LPUSHX key element [element ...]
+	if len(cmd.args) < 2 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	val, ok := lookupList(cmd.args[0])
+	if !ok {
+		return resp.EncodeSimpleError(errWrongType)
+	}
+
+	// Only push onto lists that already exist
+	if val == nil {
+		return resp.EncodeInteger(0)
+	}
+
+	for _, element := range cmd.args[1:] {
+		val.ListData.PushLeft(element)
+	}
+
+	return resp.EncodeInteger(int64(val.ListData.Len()))
+}
@@ -41,7 +41,7 @@ func (cmd *LRangeCommand) Execute(con *client.Client) RESPValue {
 		return resp.EncodeSimpleError(errWrongType)
 	}
 
-	length := len(val.ListData)
+	length := val.ListData.Len()
 
 	// Handle negative indices
 	if start < 0 {
@@ -63,10 +63,5 @@ func (cmd *LRangeCommand) Execute(con *client.Client) RESPValue {
 	}
 
 	// Get range
-	result := make([][]byte, 0, stop-start+1)
-	for i := start; i <= stop; i++ {
-		result = append(result, resp.EncodeBulkString(val.ListData[i]))
-	}
-
-	return resp.EncodeArray(result)
+	return resp.EncodeArrayBulk(val.ListData.Range(start, stop)...)
 }
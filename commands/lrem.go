@@ -0,0 +1,68 @@
+package command
+
+import (
+	"strconv"
+
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+type LRemCommand Command
+
+func (cmd *LRemCommand) Execute(con *client.Client) RESPValue {
+	// LREM key count element
+	if len(cmd.args) != 3 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	key := cmd.args[0]
+	count, err := strconv.Atoi(cmd.args[1])
+	if err != nil {
+		return resp.EncodeSimpleError(errNotInteger)
+	}
+	element := cmd.args[2]
+
+	val, ok := lookupList(key)
+	if !ok {
+		return resp.EncodeSimpleError(errWrongType)
+	}
+	if val == nil {
+		return resp.EncodeInteger(0)
+	}
+
+	// count > 0 removes from head to tail, count < 0 from tail to head, 0 removes all
+	limit := count
+	if limit < 0 {
+		limit = -limit
+	}
+
+	length := val.ListData.Len()
+	remove := make([]bool, length)
+	removed := 0
+	val.ListData.Iterate(count < 0, func(pos int, item string) bool {
+		if item == element {
+			remove[pos] = true
+			removed++
+		}
+		return limit == 0 || removed < limit
+	})
+	if removed == 0 {
+		return resp.EncodeInteger(0)
+	}
+
+	kept := make([]string, 0, length-removed)
+	val.ListData.Iterate(false, func(i int, item string) bool {
+		if !remove[i] {
+			kept = append(kept, item)
+		}
+		return true
+	})
+	val.ListData = store.NewList(kept...)
+
+	if val.ListData.Len() == 0 {
+		store.Delete(key)
+	}
+
+	return resp.EncodeInteger(int64(removed))
+}
@@ -0,0 +1,38 @@
+package command
+
+import (
+	"strconv"
+
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+)
+
+type LSetCommand Command
+
+func (cmd *LSetCommand) Execute(con *client.Client) RESPValue {
+	// LSET key index element
+	if len(cmd.args) != 3 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	index, err := strconv.Atoi(cmd.args[1])
+	if err != nil {
+		return resp.EncodeSimpleError(errNotInteger)
+	}
+
+	val, ok := lookupList(cmd.args[0])
+	if !ok {
+		return resp.EncodeSimpleError(errWrongType)
+	}
+	if val == nil {
+		return resp.EncodeSimpleError("ERR no such key")
+	}
+
+	index, inRange := normalizeListIndex(index, val.ListData.Len())
+	if !inRange {
+		return resp.EncodeSimpleError("ERR index out of range")
+	}
+
+	val.ListData.Set(index, cmd.args[2])
+	return resp.Success()
+}
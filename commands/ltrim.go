@@ -0,0 +1,61 @@
+package command
+
+import (
+	"strconv"
+
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+type LTrimCommand Command
+
+func (cmd *LTrimCommand) Execute(con *client.Client) RESPValue {
+	// LTRIM key start stop
+	if len(cmd.args) != 3 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	key := cmd.args[0]
+	start, err := strconv.Atoi(cmd.args[1])
+	if err != nil {
+		return resp.EncodeSimpleError(errNotInteger)
+	}
+	stop, err := strconv.Atoi(cmd.args[2])
+	if err != nil {
+		return resp.EncodeSimpleError(errNotInteger)
+	}
+
+	val, ok := lookupList(key)
+	if !ok {
+		return resp.EncodeSimpleError(errWrongType)
+	}
+	if val == nil {
+		return resp.Success()
+	}
+
+	length := val.ListData.Len()
+
+	// Handle negative indices
+	if start < 0 {
+		start = length + start
+		if start < 0 {
+			start = 0
+		}
+	}
+	if stop < 0 {
+		stop = length + stop
+	}
+	if stop >= length {
+		stop = length - 1
+	}
+
+	// Out of range trims everything
+	if start > stop || start >= length {
+		store.Delete(key)
+		return resp.Success()
+	}
+
+	val.ListData.Trim(start, stop)
+	return resp.Success()
+}
@@ -0,0 +1,22 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/pubsub"
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+)
+
+type PSubscribeCommand Command
+
+func (cmd *PSubscribeCommand) Execute(con *client.Client) RESPValue {
+	if len(cmd.args) == 0 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	var response []byte
+	for _, pattern := range cmd.args {
+		count := pubsub.Global.PSubscribe(con, pattern)
+		response = append(response, resp.EncodePubSubResponse("psubscribe", pattern, count)...)
+	}
+	return response
+}
@@ -0,0 +1,22 @@
+package command
+
+import (
+	"slices"
+
+	"github.com/SuchintK/GoDisKV/pubsub"
+	"github.com/SuchintK/GoDisKV/resp/client"
+)
+
+type PUnsubscribeCommand Command
+
+func (cmd *PUnsubscribeCommand) Execute(con *client.Client) RESPValue {
+	patterns := cmd.args
+	if len(patterns) == 0 {
+		// Unsubscribe from all patterns
+		patterns = con.GetSubscribedPatterns()
+		slices.Sort(patterns)
+	}
+	return unsubscribeReplies("punsubscribe", patterns, con.SubscriptionCount(), func(pattern string) int {
+		return pubsub.Global.PUnsubscribe(con, pattern)
+	})
+}
@@ -3,7 +3,6 @@ package command
 import (
 	"github.com/SuchintK/GoDisKV/resp"
 	"github.com/SuchintK/GoDisKV/resp/client"
-	"github.com/SuchintK/GoDisKV/store"
 )
 
 type RPopCommand Command
@@ -14,34 +13,14 @@ func (cmd *RPopCommand) Execute(con *client.Client) RESPValue {
 	}
 
 	key := cmd.args[0]
-	val, exists := store.Get(key)
 
-	// Key doesn't exist
-	if !exists || val.ListData == nil {
-		return resp.EncodeNullBulkString()
-	}
-
-	// Check if it's not a list
-	if val.ListData == nil {
+	elements, ok := listPopCount(key, false, 1)
+	if !ok {
 		return resp.EncodeSimpleError(errWrongType)
 	}
-
-	// Empty list
-	if len(val.ListData) == 0 {
+	if len(elements) == 0 {
 		return resp.EncodeNullBulkString()
 	}
 
-	// Pop the last element
-	lastIndex := len(val.ListData) - 1
-	element := val.ListData[lastIndex]
-	val.ListData = val.ListData[:lastIndex]
-
-	// Update or delete the key
-	if len(val.ListData) == 0 {
-		store.Delete(key)
-	} else {
-		store.Set(key, val)
-	}
-
-	return resp.EncodeBulkString(element)
+	return resp.EncodeBulkString(elements[0])
 }
@@ -0,0 +1,25 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+)
+
+type RPopLPushCommand Command
+
+func (cmd *RPopLPushCommand) Execute(con *client.Client) RESPValue {
+	// RPOPLPUSH source destination
+	if len(cmd.args) != 2 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	element, moved, err := listMove(cmd.args[0], cmd.args[1], false, true)
+	if err != nil {
+		return resp.EncodeSimpleError(err.Error())
+	}
+	if !moved {
+		return resp.EncodeNullBulkString()
+	}
+
+	return resp.EncodeBulkString(element)
+}
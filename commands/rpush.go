@@ -16,24 +16,32 @@ func (cmd *RPushCommand) Execute(con *client.Client) RESPValue {
 	key := cmd.args[0]
 	elements := cmd.args[1:]
 
-	val, exists := store.Get(key)
+	var result RESPValue
+	store.Atomically(func(tx store.Tx) {
+		val, exists := tx.Get(key)
+
+		// Check if key exists and is not a list
+		if exists && val.ListData == nil {
+			result = resp.EncodeSimpleError(errWrongType)
+			return
+		}
 
-	// Check if key exists and is not a list
-	if exists && val.ListData == nil && val.Data != "" {
-		return resp.EncodeSimpleError(errWrongType)
-	}
+		// Initialize list if it doesn't exist
+		if !exists || val.ListData == nil {
+			val = &store.Value{
+				ListData: store.NewList(),
+			}
+		}
 
-	// Initialize list if it doesn't exist
-	if !exists || val.ListData == nil {
-		val = &store.Value{
-			ListData: []string{},
+		// Push elements to the tail
+		for _, element := range elements {
+			val.ListData.PushRight(element)
 		}
-	}
 
-	// Push elements to the tail (append)
-	val.ListData = append(val.ListData, elements...)
+		tx.Set(key, val)
 
-	store.Set(key, val)
+		result = resp.EncodeInteger(int64(val.ListData.Len()))
+	})
 
-	return resp.EncodeInteger(int64(len(val.ListData)))
+	return result
 }
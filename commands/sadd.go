@@ -0,0 +1,57 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+type SAddCommand Command
+
+func (cmd *SAddCommand) Execute(con *client.Client) RESPValue {
+	// SADD key member [member ...]
+	if len(cmd.args) < 2 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	set, ok := getOrCreateSet(cmd.args[0])
+	if !ok {
+		return resp.EncodeSimpleError(errWrongType)
+	}
+
+	added := 0
+	for _, member := range cmd.args[1:] {
+		if set.Add(member) {
+			added++
+		}
+	}
+
+	return resp.EncodeInteger(int64(added))
+}
+
+// lookupSet returns the set stored at key, or nil if the key does not exist
+// ok is false when the key holds a value of another type
+func lookupSet(key string) (*store.UnorderedSet, bool) {
+	val, exists := store.Get(key)
+	if !exists {
+		return nil, true
+	}
+	if val.SetData == nil {
+		return nil, false
+	}
+	return val.SetData, true
+}
+
+// getOrCreateSet returns the set stored at key, creating an empty one if needed
+// ok is false when the key holds a value of another type
+func getOrCreateSet(key string) (*store.UnorderedSet, bool) {
+	set, ok := lookupSet(key)
+	if !ok {
+		return nil, false
+	}
+	if set == nil {
+		set = store.NewUnorderedSet()
+		store.Set(key, &store.Value{SetData: set})
+	}
+	return set, true
+}
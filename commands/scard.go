@@ -0,0 +1,25 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+)
+
+type SCardCommand Command
+
+func (cmd *SCardCommand) Execute(con *client.Client) RESPValue {
+	// SCARD key
+	if len(cmd.args) != 1 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	set, ok := lookupSet(cmd.args[0])
+	if !ok {
+		return resp.EncodeSimpleError(errWrongType)
+	}
+	if set == nil {
+		return resp.EncodeInteger(0)
+	}
+
+	return resp.EncodeInteger(int64(set.Card()))
+}
@@ -0,0 +1,22 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+)
+
+type SDiffCommand Command
+
+func (cmd *SDiffCommand) Execute(con *client.Client) RESPValue {
+	// SDIFF key [key ...]
+	if len(cmd.args) < 1 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	result, err := setAlgebra(setOpDiff, cmd.args)
+	if err != nil {
+		return resp.EncodeSimpleError(err.Error())
+	}
+
+	return resp.EncodeArrayBulk(result.Members()...)
+}
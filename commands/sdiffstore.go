@@ -0,0 +1,17 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+)
+
+type SDiffStoreCommand Command
+
+func (cmd *SDiffStoreCommand) Execute(con *client.Client) RESPValue {
+	// SDIFFSTORE destination key [key ...]
+	if len(cmd.args) < 2 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	return storeSetAlgebra(setOpDiff, cmd.args[0], cmd.args[1:])
+}
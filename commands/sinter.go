@@ -0,0 +1,125 @@
+package command
+
+import (
+	"errors"
+
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+type SInterCommand Command
+
+// Set algebra operations shared by SINTER, SUNION, SDIFF and their STORE variants
+const (
+	setOpInter = iota
+	setOpUnion
+	setOpDiff
+)
+
+var errSetWrongType = errors.New(errWrongType)
+
+func (cmd *SInterCommand) Execute(con *client.Client) RESPValue {
+	// SINTER key [key ...]
+	if len(cmd.args) < 1 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	result, err := setAlgebra(setOpInter, cmd.args)
+	if err != nil {
+		return resp.EncodeSimpleError(err.Error())
+	}
+
+	return resp.EncodeArrayBulk(result.Members()...)
+}
+
+// setAlgebra computes the intersection, union or difference of the sets stored at keys
+// Missing keys are treated as empty sets
+func setAlgebra(op int, keys []string) (*store.UnorderedSet, error) {
+	sets, err := lookupSets(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	result := store.NewUnorderedSet()
+	switch op {
+	case setOpInter:
+		intersect(sets, func(member string) bool {
+			result.Add(member)
+			return true
+		})
+	case setOpUnion:
+		for _, set := range sets {
+			for _, member := range set.Members() {
+				result.Add(member)
+			}
+		}
+	case setOpDiff:
+		for _, member := range sets[0].Members() {
+			inOther := false
+			for _, set := range sets[1:] {
+				if set.Contains(member) {
+					inOther = true
+					break
+				}
+			}
+			if !inOther {
+				result.Add(member)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// lookupSets returns the sets stored at keys, with missing keys as empty sets
+func lookupSets(keys []string) ([]*store.UnorderedSet, error) {
+	sets := make([]*store.UnorderedSet, len(keys))
+	for i, key := range keys {
+		set, ok := lookupSet(key)
+		if !ok {
+			return nil, errSetWrongType
+		}
+		if set == nil {
+			set = store.NewUnorderedSet()
+		}
+		sets[i] = set
+	}
+	return sets, nil
+}
+
+// intersect calls fn with each member present in all sets until fn returns false
+// It iterates the smallest set and probes the others
+func intersect(sets []*store.UnorderedSet, fn func(member string) bool) {
+	smallest := sets[0]
+	for _, set := range sets[1:] {
+		if set.Card() < smallest.Card() {
+			smallest = set
+		}
+	}
+	smallest.Iterate(func(member string) bool {
+		for _, set := range sets {
+			if !set.Contains(member) {
+				return true
+			}
+		}
+		return fn(member)
+	})
+}
+
+// storeSetAlgebra implements the *STORE variants: the result overwrites destination,
+// which is deleted when the result is empty. Returns the cardinality of the result.
+func storeSetAlgebra(op int, destination string, keys []string) RESPValue {
+	result, err := setAlgebra(op, keys)
+	if err != nil {
+		return resp.EncodeSimpleError(err.Error())
+	}
+
+	if result.Card() == 0 {
+		store.Delete(destination)
+	} else {
+		store.Set(destination, &store.Value{SetData: result})
+	}
+
+	return resp.EncodeInteger(int64(result.Card()))
+}
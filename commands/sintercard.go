@@ -0,0 +1,58 @@
+package command
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+)
+
+type SInterCardCommand Command
+
+func (cmd *SInterCardCommand) Execute(con *client.Client) RESPValue {
+	// SINTERCARD numkeys key [key ...] [LIMIT limit]
+	if len(cmd.args) < 2 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	numKeys, err := strconv.Atoi(cmd.args[0])
+	if err != nil || numKeys <= 0 {
+		return resp.EncodeSimpleError("ERR numkeys should be greater than 0")
+	}
+	if numKeys > len(cmd.args)-1 {
+		return resp.EncodeSimpleError("ERR Number of keys can't be greater than number of args")
+	}
+
+	keys := cmd.args[1 : 1+numKeys]
+	rest := cmd.args[1+numKeys:]
+
+	// LIMIT 0 means unlimited
+	limit := 0
+	if len(rest) > 0 {
+		if len(rest) != 2 || strings.ToUpper(rest[0]) != "LIMIT" {
+			return resp.EncodeSimpleError(errSyntax)
+		}
+		limit, err = strconv.Atoi(rest[1])
+		if err != nil {
+			return resp.EncodeSimpleError(errNotInteger)
+		}
+		if limit < 0 {
+			return resp.EncodeSimpleError("ERR LIMIT can't be negative")
+		}
+	}
+
+	sets, err := lookupSets(keys)
+	if err != nil {
+		return resp.EncodeSimpleError(err.Error())
+	}
+
+	// Stop counting as soon as the limit is reached
+	card := 0
+	intersect(sets, func(member string) bool {
+		card++
+		return limit == 0 || card < limit
+	})
+
+	return resp.EncodeInteger(int64(card))
+}
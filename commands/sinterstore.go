@@ -0,0 +1,17 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+)
+
+type SInterStoreCommand Command
+
+func (cmd *SInterStoreCommand) Execute(con *client.Client) RESPValue {
+	// SINTERSTORE destination key [key ...]
+	if len(cmd.args) < 2 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	return storeSetAlgebra(setOpInter, cmd.args[0], cmd.args[1:])
+}
@@ -0,0 +1,25 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+)
+
+type SIsMemberCommand Command
+
+func (cmd *SIsMemberCommand) Execute(con *client.Client) RESPValue {
+	// SISMEMBER key member
+	if len(cmd.args) != 2 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	set, ok := lookupSet(cmd.args[0])
+	if !ok {
+		return resp.EncodeSimpleError(errWrongType)
+	}
+	if set == nil || !set.Contains(cmd.args[1]) {
+		return resp.EncodeInteger(0)
+	}
+
+	return resp.EncodeInteger(1)
+}
@@ -0,0 +1,25 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+)
+
+type SMembersCommand Command
+
+func (cmd *SMembersCommand) Execute(con *client.Client) RESPValue {
+	// SMEMBERS key
+	if len(cmd.args) != 1 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	set, ok := lookupSet(cmd.args[0])
+	if !ok {
+		return resp.EncodeSimpleError(errWrongType)
+	}
+	if set == nil {
+		return resp.EncodeArray([][]byte{})
+	}
+
+	return resp.EncodeArrayBulk(set.Members()...)
+}
@@ -0,0 +1,32 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+)
+
+type SMIsMemberCommand Command
+
+func (cmd *SMIsMemberCommand) Execute(con *client.Client) RESPValue {
+	// SMISMEMBER key member [member ...]
+	if len(cmd.args) < 2 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	set, ok := lookupSet(cmd.args[0])
+	if !ok {
+		return resp.EncodeSimpleError(errWrongType)
+	}
+
+	members := cmd.args[1:]
+	results := make([][]byte, len(members))
+	for i, member := range members {
+		if set != nil && set.Contains(member) {
+			results[i] = resp.EncodeInteger(1)
+		} else {
+			results[i] = resp.EncodeInteger(0)
+		}
+	}
+
+	return resp.EncodeArray(results)
+}
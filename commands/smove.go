@@ -0,0 +1,51 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+type SMoveCommand Command
+
+func (cmd *SMoveCommand) Execute(con *client.Client) RESPValue {
+	// SMOVE source destination member
+	if len(cmd.args) != 3 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	source := cmd.args[0]
+	destination := cmd.args[1]
+	member := cmd.args[2]
+
+	src, ok := lookupSet(source)
+	if !ok {
+		return resp.EncodeSimpleError(errWrongType)
+	}
+	dst, ok := lookupSet(destination)
+	if !ok {
+		return resp.EncodeSimpleError(errWrongType)
+	}
+
+	if src == nil || !src.Contains(member) {
+		return resp.EncodeInteger(0)
+	}
+
+	// Moving within the same set is a no-op
+	if source == destination {
+		return resp.EncodeInteger(1)
+	}
+
+	src.Remove(member)
+	if src.Card() == 0 {
+		store.Delete(source)
+	}
+
+	if dst == nil {
+		dst = store.NewUnorderedSet()
+		store.Set(destination, &store.Value{SetData: dst})
+	}
+	dst.Add(member)
+
+	return resp.EncodeInteger(1)
+}
@@ -0,0 +1,58 @@
+package command
+
+import (
+	"strconv"
+
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+type SPopCommand Command
+
+func (cmd *SPopCommand) Execute(con *client.Client) RESPValue {
+	// SPOP key [count]
+	if len(cmd.args) < 1 || len(cmd.args) > 2 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	key := cmd.args[0]
+	count := 1
+	if len(cmd.args) == 2 {
+		var err error
+		count, err = strconv.Atoi(cmd.args[1])
+		if err != nil || count < 0 {
+			return resp.EncodeSimpleError("ERR value is out of range, must be positive")
+		}
+	}
+
+	set, ok := lookupSet(key)
+	if !ok {
+		return resp.EncodeSimpleError(errWrongType)
+	}
+
+	popped := []string{}
+	if set != nil {
+		popped = set.RandomDistinct(count)
+		for _, member := range popped {
+			set.Remove(member)
+		}
+		if set.Card() == 0 {
+			store.Delete(key)
+		}
+	}
+
+	// Replicas must remove the same members rather than pick their own
+	if len(popped) > 0 {
+		con.Propagate(append([]string{"srem", key}, popped...)...)
+	}
+
+	if len(cmd.args) == 1 {
+		if len(popped) == 0 {
+			return resp.EncodeNullBulkString()
+		}
+		return resp.EncodeBulkString(popped[0])
+	}
+
+	return resp.EncodeArrayBulk(popped...)
+}
@@ -0,0 +1,57 @@
+package command
+
+import (
+	"math/rand"
+
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+)
+
+type SRandMemberCommand Command
+
+func (cmd *SRandMemberCommand) Execute(con *client.Client) RESPValue {
+	// SRANDMEMBER key [count]
+	if len(cmd.args) < 1 || len(cmd.args) > 2 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	set, ok := lookupSet(cmd.args[0])
+	if !ok {
+		return resp.EncodeSimpleError(errWrongType)
+	}
+
+	// Without count a single member (or nil) is returned
+	if len(cmd.args) == 1 {
+		if set == nil {
+			return resp.EncodeNullBulkString()
+		}
+		return resp.EncodeBulkString(set.Random())
+	}
+
+	count, err := parseRandomCount(cmd.args[1])
+	if err != nil {
+		return resp.EncodeSimpleError(err.Error())
+	}
+	if set == nil || count == 0 {
+		return resp.EncodeArray([][]byte{})
+	}
+
+	members := set.Members()
+	if count > 0 {
+		// Positive count: distinct members, at most the size of the set
+		rand.Shuffle(len(members), func(i, j int) {
+			members[i], members[j] = members[j], members[i]
+		})
+		if count < len(members) {
+			members = members[:count]
+		}
+		return resp.EncodeArrayBulk(members...)
+	}
+
+	// Negative count: the same member may be returned multiple times
+	picked := make([]string, -count)
+	for i := range picked {
+		picked[i] = members[rand.Intn(len(members))]
+	}
+	return resp.EncodeArrayBulk(picked...)
+}
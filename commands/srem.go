@@ -0,0 +1,39 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+type SRemCommand Command
+
+func (cmd *SRemCommand) Execute(con *client.Client) RESPValue {
+	// SREM key member [member ...]
+	if len(cmd.args) < 2 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	key := cmd.args[0]
+	set, ok := lookupSet(key)
+	if !ok {
+		return resp.EncodeSimpleError(errWrongType)
+	}
+	if set == nil {
+		return resp.EncodeInteger(0)
+	}
+
+	removed := 0
+	for _, member := range cmd.args[1:] {
+		if set.Remove(member) {
+			removed++
+		}
+	}
+
+	// Remove the key once the set is empty
+	if set.Card() == 0 {
+		store.Delete(key)
+	}
+
+	return resp.EncodeInteger(int64(removed))
+}
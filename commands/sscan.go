@@ -0,0 +1,37 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+)
+
+type SScanCommand Command
+
+func (cmd *SScanCommand) Execute(con *client.Client) RESPValue {
+	// SSCAN key cursor [MATCH pattern] [COUNT count]
+	if len(cmd.args) < 2 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	opts, err := parseScanOptions(cmd.args[1:], false)
+	if err != nil {
+		return resp.EncodeSimpleError(err.Error())
+	}
+
+	set, ok := lookupSet(cmd.args[0])
+	if !ok {
+		return resp.EncodeSimpleError(errWrongType)
+	}
+	if set == nil {
+		return encodeScanReply(0, [][]byte{})
+	}
+
+	next, members := scanOrdered(set.ScanOrder(), opts)
+
+	items := make([][]byte, len(members))
+	for i, member := range members {
+		items[i] = resp.EncodeBulkString(member)
+	}
+
+	return encodeScanReply(next, items)
+}
@@ -9,12 +9,15 @@ import (
 type SubscribeCommand Command
 
 func (cmd *SubscribeCommand) Execute(con *client.Client) RESPValue {
-	if len(cmd.args) != 1 {
+	if len(cmd.args) == 0 {
 		return resp.EncodeSimpleError(errWrongNumberOfArgs)
 	}
 
-	channel := cmd.args[0]
-	count := pubsub.Global.Subscribe(con, channel)
-
-	return resp.EncodePubSubResponse("subscribe", channel, count)
+	// One confirmation per channel, in the order they were given
+	var response []byte
+	for _, channel := range cmd.args {
+		count := pubsub.Global.Subscribe(con, channel)
+		response = append(response, resp.EncodePubSubResponse("subscribe", channel, count)...)
+	}
+	return response
 }
@@ -0,0 +1,22 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+)
+
+type SUnionCommand Command
+
+func (cmd *SUnionCommand) Execute(con *client.Client) RESPValue {
+	// SUNION key [key ...]
+	if len(cmd.args) < 1 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	result, err := setAlgebra(setOpUnion, cmd.args)
+	if err != nil {
+		return resp.EncodeSimpleError(err.Error())
+	}
+
+	return resp.EncodeArrayBulk(result.Members()...)
+}
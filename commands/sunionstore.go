@@ -0,0 +1,17 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+)
+
+type SUnionStoreCommand Command
+
+func (cmd *SUnionStoreCommand) Execute(con *client.Client) RESPValue {
+	// SUNIONSTORE destination key [key ...]
+	if len(cmd.args) < 2 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	return storeSetAlgebra(setOpUnion, cmd.args[0], cmd.args[1:])
+}
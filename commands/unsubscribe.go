@@ -1,6 +1,8 @@
 package command
 
 import (
+	"slices"
+
 	"github.com/SuchintK/GoDisKV/pubsub"
 	"github.com/SuchintK/GoDisKV/resp"
 	"github.com/SuchintK/GoDisKV/resp/client"
@@ -9,18 +11,31 @@ import (
 type UnsubscribeCommand Command
 
 func (cmd *UnsubscribeCommand) Execute(con *client.Client) RESPValue {
-	var channel string
-	if len(cmd.args) == 0 {
+	channels := cmd.args
+	if len(channels) == 0 {
 		// Unsubscribe from all channels
-		channel = ""
-	} else if len(cmd.args) == 1 {
-		// Unsubscribe from specific channel
-		channel = cmd.args[0]
-	} else {
-		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+		channels = con.GetSubscribedChannels()
+		slices.Sort(channels)
 	}
+	return unsubscribeReplies("unsubscribe", channels, con.SubscriptionCount(), func(channel string) int {
+		return pubsub.Global.Unsubscribe(con, channel)
+	})
+}
 
-	count := pubsub.Global.Unsubscribe(con, channel)
+// unsubscribeReplies removes each name and returns one confirmation per name
+// When there is nothing to remove, the single reply carries a null name and the current count
+func unsubscribeReplies(msgType string, names []string, count int, remove func(string) int) []byte {
+	if len(names) == 0 {
+		return resp.EncodeArray([][]byte{
+			resp.EncodeBulkString(msgType),
+			resp.EncodeNullBulkString(),
+			resp.EncodeInteger(int64(count)),
+		})
+	}
 
-	return resp.EncodePubSubResponse("unsubscribe", channel, count)
+	var response []byte
+	for _, name := range names {
+		response = append(response, resp.EncodePubSubResponse(msgType, name, remove(name))...)
+	}
+	return response
 }
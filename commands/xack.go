@@ -0,0 +1,49 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+type XAckCommand Command
+
+func (cmd *XAckCommand) Execute(con *client.Client) RESPValue {
+	// XACK key group id [id ...]
+	if len(cmd.args) < 3 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	key, groupName := cmd.args[0], cmd.args[1]
+
+	// Validate every ID before acknowledging any
+	ids := make([]store.StreamID, len(cmd.args)-2)
+	for i, arg := range cmd.args[2:] {
+		id, ok := store.ParseStreamID(arg, 0)
+		if !ok {
+			return resp.EncodeSimpleError(invalidStreamID)
+		}
+		ids[i] = id
+	}
+
+	var reply RESPValue
+	store.Atomically(func(tx store.Tx) {
+		stream, ok := lookupStream(tx, key)
+		if !ok {
+			reply = resp.EncodeSimpleError(errWrongType)
+			return
+		}
+
+		acked := 0
+		if stream != nil && stream.Group(groupName) != nil {
+			group := stream.Group(groupName)
+			for _, id := range ids {
+				if group.Ack(id) {
+					acked++
+				}
+			}
+		}
+		reply = resp.EncodeInteger(int64(acked))
+	})
+	return reply
+}
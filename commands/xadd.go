@@ -1,7 +1,7 @@
 package command
 
 import (
-	"fmt"
+	"math"
 	"strconv"
 	"strings"
 	"time"
@@ -14,91 +14,155 @@ import (
 type XAddCommand Command
 
 func (cmd *XAddCommand) Execute(con *client.Client) RESPValue {
-	numArgs := len(cmd.args)
-	// TODO write a proper flag parser
-	if numArgs < 4 || (numArgs-2)%2 != 0 {
+	// XADD key [NOMKSTREAM] [MAXLEN|MINID [=|~] threshold [LIMIT count]] *|id field value [field value ...]
+	if len(cmd.args) < 4 {
 		return resp.EncodeSimpleError(errWrongNumberOfArgs)
 	}
 
 	key := cmd.args[0]
-	idArg := cmd.args[1]
+	args := cmd.args[1:]
+
+	noMkStream := false
+	var trim *streamTrim
+	for len(args) > 0 {
+		if strings.ToUpper(args[0]) == "NOMKSTREAM" {
+			noMkStream = true
+			args = args[1:]
+			continue
+		}
+		parsed, n, err := parseStreamTrim(args)
+		if err != nil {
+			return resp.EncodeSimpleError(err.Error())
+		}
+		if n == 0 {
+			break
+		}
+		if trim != nil {
+			return resp.EncodeSimpleError(errTrimStrategies)
+		}
+		trim = &parsed
+		args = args[n:]
+	}
+
+	if len(args) < 3 || len(args)%2 != 1 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+	idArg := args[0]
 
-	// Parse field-value pairs
-	fields := make(map[string]string)
-	for i := 2; i < numArgs; i += 2 {
-		fields[cmd.args[i]] = cmd.args[i+1]
+	// Parse field-value pairs, keeping their order
+	fields := make([]store.StreamField, 0, len(args)/2)
+	for i := 1; i < len(args); i += 2 {
+		fields = append(fields, store.StreamField{Name: args[i], Value: args[i+1]})
 	}
 
-	// Get or create stream
-	var val *store.Value
-	var stream *store.Stream
-	val, exists := store.Get(key)
-
-	if exists {
-		stream = val.StreamData
-	} else {
-		// Create new stream
-		stream = &store.Stream{
-			Entries:       make([]*store.StreamEntry, 0),
-			LastTimestamp: 0,
-			LastSequence:  0,
+	var reply RESPValue
+	store.Atomically(func(tx store.Tx) {
+		// Get or create stream
+		stream, ok := lookupStream(tx, key)
+		if !ok {
+			reply = resp.EncodeSimpleError(errWrongType)
+			return
 		}
-		val = &store.Value{
-			StreamData: stream,
+		created := stream == nil
+		if created {
+			if noMkStream {
+				reply = resp.EncodeNullBulkString()
+				return
+			}
+			stream = store.NewStream()
 		}
-		store.Set(key, val)
-	}
 
-	// Generate or validate ID
-	var entryID string
-	if idArg == "*" {
-		// Auto-generate ID using current timestamp
-		timestamp := time.Now().UnixMilli()
-		sequence := int64(0)
-
-		if timestamp == stream.LastTimestamp {
-			sequence = stream.LastSequence + 1
-		} else if timestamp < stream.LastTimestamp {
-			// Use last timestamp + 1
-			timestamp = stream.LastTimestamp
-			sequence = stream.LastSequence + 1
+		entryID, errReply := nextStreamID(stream, idArg)
+		if errReply != nil {
+			reply = errReply
+			return
+		}
+		if created {
+			tx.Set(key, &store.Value{StreamData: stream})
+		}
+
+		// Create and add entry
+		entry := &store.StreamEntry{
+			ID:     entryID,
+			Fields: fields,
 		}
+		stream.Append(entry)
 
-		stream.LastTimestamp = timestamp
-		stream.LastSequence = sequence
-		entryID = fmt.Sprintf("%d-%d", timestamp, sequence)
-	} else {
-		// Use provided ID
-		// Basic validation - should be in format timestamp-sequence
-		parts := strings.Split(idArg, "-")
-		if len(parts) != 2 {
-			return resp.EncodeSimpleError(invalidStreamID)
+		if trim != nil {
+			trim.apply(stream)
 		}
+		reply = resp.EncodeBulkString(entryID.String())
+	})
+	return reply
+}
 
-		timestamp, err1 := strconv.ParseInt(parts[0], 10, 64)
-		sequence, err2 := strconv.ParseInt(parts[1], 10, 64)
+// nextStreamID generates or validates the ID of an entry added to the stream
+// idArg is * to generate the whole ID from the current time, ms-* to generate only the
+// sequence number, or a full ms-seq ID. Either way the ID must exceed the stream's last ID.
+func nextStreamID(stream *store.Stream, idArg string) (store.StreamID, RESPValue) {
+	last := stream.LastID
 
-		if err1 != nil || err2 != nil {
-			return resp.EncodeSimpleError(invalidStreamID)
+	if idArg == "*" {
+		// Auto-generate ID using current timestamp, or continue the last one if the clock is behind
+		now := uint64(time.Now().UnixMilli())
+		if now > last.Ms {
+			return store.StreamID{Ms: now}, nil
 		}
+		next, ok := last.Next()
+		if !ok {
+			return store.StreamID{}, resp.EncodeSimpleError(streamExhausted)
+		}
+		return next, nil
+	}
 
-		// Check if ID is greater than last ID
-		if len(stream.Entries) > 0 {
-			if timestamp < stream.LastTimestamp || (timestamp == stream.LastTimestamp && sequence <= stream.LastSequence) {
-				return resp.EncodeSimpleError(idGreaterThanTopElement)
-			}
+	// Use provided ID
+	// Basic validation - should be in format timestamp-sequence, where sequence may be *
+	msPart, seqPart, found := strings.Cut(idArg, "-")
+	if !found {
+		return store.StreamID{}, resp.EncodeSimpleError(invalidStreamID)
+	}
+	ms, err := strconv.ParseUint(msPart, 10, 64)
+	if err != nil {
+		return store.StreamID{}, resp.EncodeSimpleError(invalidStreamID)
+	}
+
+	if seqPart == "*" {
+		switch {
+		case ms > last.Ms:
+			return store.StreamID{Ms: ms}, nil
+		case ms < last.Ms || last.Seq == math.MaxUint64:
+			return store.StreamID{}, resp.EncodeSimpleError(idGreaterThanTopElement)
+		default:
+			// Continues the last ID's millisecond, and 0-* starts at 0-1 since 0-0 is never valid
+			return store.StreamID{Ms: ms, Seq: last.Seq + 1}, nil
 		}
+	}
 
-		stream.LastTimestamp = timestamp
-		stream.LastSequence = sequence
-		entryID = idArg
+	seq, err := strconv.ParseUint(seqPart, 10, 64)
+	if err != nil {
+		return store.StreamID{}, resp.EncodeSimpleError(invalidStreamID)
 	}
+	id := store.StreamID{Ms: ms, Seq: seq}
 
-	// Create and add entry
-	entry := &store.StreamEntry{
-		Id:     entryID,
-		Fields: fields,
+	// Check if ID is greater than last ID, even if the entries holding it were deleted since
+	if id == (store.StreamID{}) {
+		return store.StreamID{}, resp.EncodeSimpleError(idZero)
+	}
+	if id.Compare(last) <= 0 {
+		return store.StreamID{}, resp.EncodeSimpleError(idGreaterThanTopElement)
+	}
+	return id, nil
+}
+
+// lookupStream returns the stream stored at key, nil if the key does not exist,
+// and false if the key holds another type
+func lookupStream(tx store.Tx, key string) (*store.Stream, bool) {
+	val, exists := tx.Get(key)
+	if !exists {
+		return nil, true
+	}
+	if val.StreamData == nil {
+		return nil, false
 	}
-	stream.Entries = append(stream.Entries, entry)
-	return resp.EncodeBulkString(entryID)
+	return val.StreamData, true
 }
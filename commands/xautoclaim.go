@@ -0,0 +1,105 @@
+package command
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+type XAutoClaimCommand Command
+
+// xautoclaimAttemptsFactor bounds how many pending entries a call may look at, per entry
+// it is allowed to claim
+const xautoclaimAttemptsFactor = 10
+
+func (cmd *XAutoClaimCommand) Execute(con *client.Client) RESPValue {
+	// XAUTOCLAIM key group consumer min-idle-time start [COUNT count] [JUSTID]
+	if len(cmd.args) < 5 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	key, groupName, consumerName := cmd.args[0], cmd.args[1], cmd.args[2]
+
+	minIdleMs, err := strconv.ParseInt(cmd.args[3], 10, 64)
+	if err != nil {
+		return resp.EncodeSimpleError(errMinIdleTime)
+	}
+	minIdle := time.Duration(max(minIdleMs, 0)) * time.Millisecond
+
+	start, ok := parseStreamRangeBound(cmd.args[4], true)
+	if !ok {
+		return resp.EncodeSimpleError(invalidStreamID)
+	}
+
+	count, justID := 100, false
+	for i := 5; i < len(cmd.args); i++ {
+		switch option := strings.ToUpper(cmd.args[i]); {
+		case option == "COUNT" && i+1 < len(cmd.args):
+			n, err := strconv.Atoi(cmd.args[i+1])
+			if err != nil {
+				return resp.EncodeSimpleError(errNotInteger)
+			}
+			if n < 1 {
+				return resp.EncodeSimpleError("ERR COUNT must be > 0")
+			}
+			count = n
+			i++
+		case option == "JUSTID":
+			justID = true
+		default:
+			return resp.EncodeSimpleError(errSyntax)
+		}
+	}
+
+	var reply RESPValue
+	store.Atomically(func(tx store.Tx) {
+		stream, ok := lookupStream(tx, key)
+		if !ok {
+			reply = resp.EncodeSimpleError(errWrongType)
+			return
+		}
+		if stream == nil || stream.Group(groupName) == nil {
+			reply = resp.EncodeSimpleError(errNoGroup(key, groupName))
+			return
+		}
+		group := stream.Group(groupName)
+
+		now := time.Now()
+		consumer := group.Consumer(consumerName, now)
+		consumer.SeenAt = now
+
+		// Scan the pending entries from start, claiming up to count idle ones
+		// The cursor returned is where the next call should resume, or 0-0 once the scan is over.
+		claimed, deleted := [][]byte{}, [][]byte{}
+		next := store.StreamID{}
+		attempts := count * xautoclaimAttemptsFactor
+		group.Pending.Range(start, func(pending *store.PendingEntry) bool {
+			if len(claimed) == count || attempts == 0 {
+				next = pending.ID
+				return false
+			}
+			attempts--
+
+			if pending.Idle(now) < minIdle {
+				return true
+			}
+			if entry := claimPending(stream, group, consumer, pending.ID, now, -1, justID); entry != nil {
+				claimed = append(claimed, entry)
+			} else {
+				deleted = append(deleted, resp.EncodeBulkString(pending.ID.String()))
+			}
+			return true
+		})
+
+		reply = resp.EncodeArray([][]byte{
+			resp.EncodeBulkString(next.String()),
+			resp.EncodeArray(claimed),
+			resp.EncodeArray(deleted),
+		})
+	})
+	return reply
+}
@@ -0,0 +1,175 @@
+package command
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+type XClaimCommand Command
+
+const errMinIdleTime = "ERR Invalid min-idle-time argument for XCLAIM"
+
+// xclaimRequest is a parsed XCLAIM command
+type xclaimRequest struct {
+	group       string
+	consumer    string
+	minIdle     time.Duration
+	ids         []store.StreamID
+	deliveredAt *time.Time // set by IDLE or TIME
+	retryCount  int64      // -1 unless RETRYCOUNT is given
+	force       bool
+	justID      bool
+	lastID      *store.StreamID
+}
+
+func (cmd *XClaimCommand) Execute(con *client.Client) RESPValue {
+	// XCLAIM key group consumer min-idle-time id [id ...] [IDLE ms] [TIME unix-time-milliseconds]
+	//   [RETRYCOUNT count] [FORCE] [JUSTID] [LASTID id]
+	if len(cmd.args) < 5 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	key := cmd.args[0]
+	req, err := parseXClaimArgs(cmd.args[1:], time.Now())
+	if err != nil {
+		return resp.EncodeSimpleError(err.Error())
+	}
+
+	var reply RESPValue
+	store.Atomically(func(tx store.Tx) {
+		stream, ok := lookupStream(tx, key)
+		if !ok {
+			reply = resp.EncodeSimpleError(errWrongType)
+			return
+		}
+		if stream == nil || stream.Group(req.group) == nil {
+			reply = resp.EncodeSimpleError(errNoGroup(key, req.group))
+			return
+		}
+		group := stream.Group(req.group)
+
+		now := time.Now()
+		consumer := group.Consumer(req.consumer, now)
+		consumer.SeenAt = now
+
+		if req.lastID != nil && req.lastID.Compare(group.LastDelivered) > 0 {
+			group.LastDelivered = *req.lastID
+		}
+
+		deliveredAt := now
+		if req.deliveredAt != nil {
+			deliveredAt = *req.deliveredAt
+		}
+
+		claimed := [][]byte{}
+		for _, id := range req.ids {
+			pending, exists := group.Pending.Get(id)
+			if !exists {
+				// FORCE claims entries that were never delivered, as long as they still exist
+				if !req.force || stream.Lookup(id) == nil {
+					continue
+				}
+			} else if pending.Idle(now) < req.minIdle {
+				continue
+			}
+
+			if entry := claimPending(stream, group, consumer, id, deliveredAt, req.retryCount, req.justID); entry != nil {
+				claimed = append(claimed, entry)
+			}
+		}
+		reply = resp.EncodeArray(claimed)
+	})
+	return reply
+}
+
+// parseXClaimArgs parses the arguments of XCLAIM following the key
+func parseXClaimArgs(args []string, now time.Time) (xclaimRequest, error) {
+	req := xclaimRequest{group: args[0], consumer: args[1], retryCount: -1}
+
+	minIdle, err := strconv.ParseInt(args[2], 10, 64)
+	if err != nil {
+		return req, errors.New(errMinIdleTime)
+	}
+	req.minIdle = time.Duration(max(minIdle, 0)) * time.Millisecond
+
+	// IDs come first, options follow the last one
+	i := 3
+	for ; i < len(args); i++ {
+		id, ok := store.ParseStreamID(args[i], 0)
+		if !ok {
+			break
+		}
+		req.ids = append(req.ids, id)
+	}
+	if len(req.ids) == 0 {
+		return req, errors.New(invalidStreamID)
+	}
+
+	for ; i < len(args); i++ {
+		option := strings.ToUpper(args[i])
+		switch {
+		case option == "FORCE":
+			req.force = true
+		case option == "JUSTID":
+			req.justID = true
+		case (option == "IDLE" || option == "TIME" || option == "RETRYCOUNT") && i+1 < len(args):
+			n, err := strconv.ParseInt(args[i+1], 10, 64)
+			if err != nil {
+				return req, errors.New(errNotInteger)
+			}
+			switch option {
+			case "IDLE":
+				deliveredAt := now.Add(-time.Duration(max(n, 0)) * time.Millisecond)
+				req.deliveredAt = &deliveredAt
+			case "TIME":
+				deliveredAt := time.UnixMilli(min(n, now.UnixMilli()))
+				req.deliveredAt = &deliveredAt
+			case "RETRYCOUNT":
+				req.retryCount = max(n, 0)
+			}
+			i++
+		case option == "LASTID" && i+1 < len(args):
+			id, ok := store.ParseStreamID(args[i+1], 0)
+			if !ok {
+				return req, errors.New(invalidStreamID)
+			}
+			req.lastID = &id
+			i++
+		default:
+			return req, errors.New("ERR Unrecognized XCLAIM option '" + args[i] + "'")
+		}
+	}
+
+	return req, nil
+}
+
+// claimPending transfers the entry id to consumer and returns the entry (or only its ID with
+// justID) to send back
+// Claims other than JUSTID count as a delivery unless retryCount sets the count explicitly.
+// Entries deleted from the stream are dropped from the pending entries list and nil is returned.
+func claimPending(stream *store.Stream, group *store.ConsumerGroup, consumer *store.Consumer, id store.StreamID, deliveredAt time.Time, retryCount int64, justID bool) []byte {
+	entry := stream.Lookup(id)
+	if entry == nil {
+		group.Ack(id)
+		return nil
+	}
+
+	pending := group.Claim(consumer, id, deliveredAt)
+	switch {
+	case retryCount >= 0:
+		pending.DeliveryCount = retryCount
+	case !justID:
+		pending.DeliveryCount++
+	}
+
+	if justID {
+		return resp.EncodeBulkString(id.String())
+	}
+	return encodeStreamEntry(entry)
+}
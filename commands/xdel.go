@@ -0,0 +1,49 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+type XDelCommand Command
+
+func (cmd *XDelCommand) Execute(con *client.Client) RESPValue {
+	// XDEL key id [id ...]
+	if len(cmd.args) < 2 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	key := cmd.args[0]
+
+	// Validate every ID before deleting any
+	ids := make([]store.StreamID, len(cmd.args)-1)
+	for i, arg := range cmd.args[1:] {
+		id, ok := store.ParseStreamID(arg, 0)
+		if !ok {
+			return resp.EncodeSimpleError(invalidStreamID)
+		}
+		ids[i] = id
+	}
+
+	var reply RESPValue
+	store.Atomically(func(tx store.Tx) {
+		stream, ok := lookupStream(tx, key)
+		if !ok {
+			reply = resp.EncodeSimpleError(errWrongType)
+			return
+		}
+
+		// Deleted entries stay in the pending entries lists of consumer groups
+		deleted := 0
+		if stream != nil {
+			for _, id := range ids {
+				if stream.Delete(id) {
+					deleted++
+				}
+			}
+		}
+		reply = resp.EncodeInteger(int64(deleted))
+	})
+	return reply
+}
@@ -0,0 +1,157 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+type XGroupCommand Command
+
+const (
+	errBusyGroup      = "BUSYGROUP Consumer Group name already exists"
+	errXGroupNoStream = "ERR The XGROUP subcommand requires the key to exist. Note that for CREATE you may want to use the MKSTREAM option to create an empty stream automatically."
+)
+
+// errNoGroup is the error for a consumer group missing from the stream at key
+func errNoGroup(key, group string) string {
+	return fmt.Sprintf("NOGROUP No such consumer group '%s' for key name '%s'", group, key)
+}
+
+func (cmd *XGroupCommand) Execute(con *client.Client) RESPValue {
+	// XGROUP CREATE key group id|$ [MKSTREAM]
+	// XGROUP SETID key group id|$
+	// XGROUP DESTROY key group
+	// XGROUP CREATECONSUMER key group consumer
+	// XGROUP DELCONSUMER key group consumer
+	if len(cmd.args) < 3 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	subcommand := strings.ToUpper(cmd.args[0])
+	key, groupName := cmd.args[1], cmd.args[2]
+	args := cmd.args[3:]
+
+	switch subcommand {
+	case "CREATE":
+		if len(args) < 1 || len(args) > 2 {
+			return resp.EncodeSimpleError(errWrongNumberOfArgs)
+		}
+		mkStream := len(args) == 2
+		if mkStream && strings.ToUpper(args[1]) != "MKSTREAM" {
+			return resp.EncodeSimpleError(errSyntax)
+		}
+		return xgroupCreate(key, groupName, args[0], mkStream)
+	case "SETID":
+		if len(args) != 1 {
+			return resp.EncodeSimpleError(errWrongNumberOfArgs)
+		}
+	case "DESTROY":
+		if len(args) != 0 {
+			return resp.EncodeSimpleError(errWrongNumberOfArgs)
+		}
+	case "CREATECONSUMER", "DELCONSUMER":
+		if len(args) != 1 {
+			return resp.EncodeSimpleError(errWrongNumberOfArgs)
+		}
+	default:
+		return resp.EncodeSimpleError("ERR unknown subcommand '" + cmd.args[0] + "'")
+	}
+
+	var reply RESPValue
+	store.Atomically(func(tx store.Tx) {
+		stream, ok := lookupStream(tx, key)
+		if !ok {
+			reply = resp.EncodeSimpleError(errWrongType)
+			return
+		}
+		if stream == nil {
+			reply = resp.EncodeSimpleError(errXGroupNoStream)
+			return
+		}
+
+		if subcommand == "DESTROY" {
+			reply = resp.EncodeInteger(0)
+			if stream.DestroyGroup(groupName) {
+				reply = resp.EncodeInteger(1)
+			}
+			return
+		}
+
+		group := stream.Group(groupName)
+		if group == nil {
+			reply = resp.EncodeSimpleError(errNoGroup(key, groupName))
+			return
+		}
+
+		switch subcommand {
+		case "SETID":
+			id, ok := parseGroupStartID(stream, args[0])
+			if !ok {
+				reply = resp.EncodeSimpleError(invalidStreamID)
+				return
+			}
+			group.SetID(id)
+			reply = resp.Success()
+		case "CREATECONSUMER":
+			reply = resp.EncodeInteger(0)
+			if group.CreateConsumer(args[0], time.Now()) {
+				reply = resp.EncodeInteger(1)
+			}
+		case "DELCONSUMER":
+			pending, _ := group.DeleteConsumer(args[0])
+			reply = resp.EncodeInteger(int64(pending))
+		}
+	})
+	return reply
+}
+
+// xgroupCreate implements XGROUP CREATE, creating an empty stream first with MKSTREAM
+func xgroupCreate(key, groupName, idArg string, mkStream bool) RESPValue {
+	var reply RESPValue
+	store.Atomically(func(tx store.Tx) {
+		stream, ok := lookupStream(tx, key)
+		if !ok {
+			reply = resp.EncodeSimpleError(errWrongType)
+			return
+		}
+		if stream == nil && !mkStream {
+			reply = resp.EncodeSimpleError(errXGroupNoStream)
+			return
+		}
+
+		created := stream == nil
+		if created {
+			stream = store.NewStream()
+		}
+
+		id, ok := parseGroupStartID(stream, idArg)
+		if !ok {
+			reply = resp.EncodeSimpleError(invalidStreamID)
+			return
+		}
+		if !stream.CreateGroup(groupName, id) {
+			reply = resp.EncodeSimpleError(errBusyGroup)
+			return
+		}
+
+		if created {
+			tx.Set(key, &store.Value{StreamData: stream})
+		}
+		reply = resp.Success()
+	})
+	return reply
+}
+
+// parseGroupStartID parses the last delivered ID given to XGROUP, where $ stands for
+// the newest entry of the stream
+func parseGroupStartID(stream *store.Stream, arg string) (store.StreamID, bool) {
+	if arg == "$" {
+		return stream.LastID, true
+	}
+	return store.ParseStreamID(arg, 0)
+}
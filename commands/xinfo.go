@@ -0,0 +1,233 @@
+package command
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+type XInfoCommand Command
+
+func (cmd *XInfoCommand) Execute(con *client.Client) RESPValue {
+	// XINFO STREAM key [FULL [COUNT count]]
+	// XINFO GROUPS key
+	// XINFO CONSUMERS key group
+	if len(cmd.args) < 2 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	subcommand := strings.ToUpper(cmd.args[0])
+	key := cmd.args[1]
+	args := cmd.args[2:]
+
+	full, count := false, 10
+	switch subcommand {
+	case "STREAM":
+		if len(args) > 0 {
+			if strings.ToUpper(args[0]) != "FULL" || (len(args) != 1 && len(args) != 3) {
+				return resp.EncodeSimpleError(errSyntax)
+			}
+			full = true
+			if len(args) == 3 {
+				if strings.ToUpper(args[1]) != "COUNT" {
+					return resp.EncodeSimpleError(errSyntax)
+				}
+				n, err := strconv.Atoi(args[2])
+				if err != nil {
+					return resp.EncodeSimpleError(errNotInteger)
+				}
+				count = max(n, 0)
+			}
+		}
+	case "GROUPS":
+		if len(args) != 0 {
+			return resp.EncodeSimpleError(errWrongNumberOfArgs)
+		}
+	case "CONSUMERS":
+		if len(args) != 1 {
+			return resp.EncodeSimpleError(errWrongNumberOfArgs)
+		}
+	default:
+		return resp.EncodeSimpleError("ERR unknown subcommand '" + cmd.args[0] + "'")
+	}
+
+	var reply RESPValue
+	store.Atomically(func(tx store.Tx) {
+		stream, ok := lookupStream(tx, key)
+		if !ok {
+			reply = resp.EncodeSimpleError(errWrongType)
+			return
+		}
+		if stream == nil {
+			reply = resp.EncodeSimpleError("ERR no such key")
+			return
+		}
+
+		now := time.Now()
+		switch subcommand {
+		case "STREAM":
+			if full {
+				reply = encodeStreamInfoFull(stream, count, now)
+			} else {
+				reply = encodeStreamInfo(stream)
+			}
+		case "GROUPS":
+			groups := [][]byte{}
+			for _, name := range stream.GroupNames() {
+				groups = append(groups, encodeGroupInfo(stream, stream.Group(name)))
+			}
+			reply = resp.EncodeArray(groups)
+		case "CONSUMERS":
+			group := stream.Group(args[0])
+			if group == nil {
+				reply = resp.EncodeSimpleError(errNoGroup(key, args[0]))
+				return
+			}
+			consumers := [][]byte{}
+			for _, name := range group.ConsumerNames() {
+				consumer := group.Consumers[name]
+				consumers = append(consumers, resp.EncodeArray([][]byte{
+					resp.EncodeBulkString("name"), resp.EncodeBulkString(name),
+					resp.EncodeBulkString("pending"), resp.EncodeInteger(int64(consumer.Pending.Len())),
+					resp.EncodeBulkString("idle"), resp.EncodeInteger(now.Sub(consumer.SeenAt).Milliseconds()),
+				}))
+			}
+			reply = resp.EncodeArray(consumers)
+		}
+	})
+	return reply
+}
+
+// encodeStreamInfo encodes the reply of XINFO STREAM as a flat field/value array
+func encodeStreamInfo(stream *store.Stream) []byte {
+	return resp.EncodeArray(append(streamInfoHeader(stream),
+		resp.EncodeBulkString("groups"), resp.EncodeInteger(int64(len(stream.Groups))),
+		resp.EncodeBulkString("first-entry"), encodeOptionalStreamEntry(stream.First()),
+		resp.EncodeBulkString("last-entry"), encodeOptionalStreamEntry(stream.Last()),
+	))
+}
+
+// encodeStreamInfoFull encodes the reply of XINFO STREAM FULL, listing at most count entries
+// and pending entries of each group and consumer (all of them when count is 0)
+func encodeStreamInfoFull(stream *store.Stream, count int, now time.Time) []byte {
+	entries := [][]byte{}
+	for _, entry := range stream.Range(store.StreamID{}, store.MaxStreamID, count) {
+		entries = append(entries, encodeStreamEntry(entry))
+	}
+
+	groups := [][]byte{}
+	for _, name := range stream.GroupNames() {
+		group := stream.Group(name)
+		lag, lagKnown := stream.Lag(group)
+
+		pending := [][]byte{}
+		for _, p := range firstPending(group.Pending, count) {
+			pending = append(pending, resp.EncodeArray([][]byte{
+				resp.EncodeBulkString(p.ID.String()),
+				resp.EncodeBulkString(p.Consumer.Name),
+				resp.EncodeInteger(p.DeliveredAt.UnixMilli()),
+				resp.EncodeInteger(p.DeliveryCount),
+			}))
+		}
+
+		consumers := [][]byte{}
+		for _, consumerName := range group.ConsumerNames() {
+			consumer := group.Consumers[consumerName]
+			owned := [][]byte{}
+			for _, p := range firstPending(consumer.Pending, count) {
+				owned = append(owned, resp.EncodeArray([][]byte{
+					resp.EncodeBulkString(p.ID.String()),
+					resp.EncodeInteger(p.DeliveredAt.UnixMilli()),
+					resp.EncodeInteger(p.DeliveryCount),
+				}))
+			}
+			consumers = append(consumers, resp.EncodeArray([][]byte{
+				resp.EncodeBulkString("name"), resp.EncodeBulkString(consumerName),
+				resp.EncodeBulkString("seen-time"), resp.EncodeInteger(consumer.SeenAt.UnixMilli()),
+				resp.EncodeBulkString("pel-count"), resp.EncodeInteger(int64(consumer.Pending.Len())),
+				resp.EncodeBulkString("pending"), resp.EncodeArray(owned),
+			}))
+		}
+
+		groups = append(groups, resp.EncodeArray([][]byte{
+			resp.EncodeBulkString("name"), resp.EncodeBulkString(name),
+			resp.EncodeBulkString("last-delivered-id"), resp.EncodeBulkString(group.LastDelivered.String()),
+			resp.EncodeBulkString("entries-read"), encodeEntriesRead(group.EntriesRead),
+			resp.EncodeBulkString("lag"), encodeLag(lag, lagKnown),
+			resp.EncodeBulkString("pel-count"), resp.EncodeInteger(int64(group.Pending.Len())),
+			resp.EncodeBulkString("pending"), resp.EncodeArray(pending),
+			resp.EncodeBulkString("consumers"), resp.EncodeArray(consumers),
+		}))
+	}
+
+	return resp.EncodeArray(append(streamInfoHeader(stream),
+		resp.EncodeBulkString("entries"), resp.EncodeArray(entries),
+		resp.EncodeBulkString("groups"), resp.EncodeArray(groups),
+	))
+}
+
+// streamInfoHeader returns the fields XINFO STREAM reports with and without FULL
+func streamInfoHeader(stream *store.Stream) [][]byte {
+	firstID := store.StreamID{}
+	if first := stream.First(); first != nil {
+		firstID = first.ID
+	}
+	return [][]byte{
+		resp.EncodeBulkString("length"), resp.EncodeInteger(int64(stream.Len())),
+		resp.EncodeBulkString("last-generated-id"), resp.EncodeBulkString(stream.LastID.String()),
+		resp.EncodeBulkString("max-deleted-entry-id"), resp.EncodeBulkString(stream.MaxDeletedID.String()),
+		resp.EncodeBulkString("entries-added"), resp.EncodeInteger(stream.EntriesAdded),
+		resp.EncodeBulkString("recorded-first-entry-id"), resp.EncodeBulkString(firstID.String()),
+	}
+}
+
+// encodeGroupInfo encodes a consumer group as XINFO GROUPS reports it
+func encodeGroupInfo(stream *store.Stream, group *store.ConsumerGroup) []byte {
+	lag, lagKnown := stream.Lag(group)
+	return resp.EncodeArray([][]byte{
+		resp.EncodeBulkString("name"), resp.EncodeBulkString(group.Name),
+		resp.EncodeBulkString("consumers"), resp.EncodeInteger(int64(len(group.Consumers))),
+		resp.EncodeBulkString("pending"), resp.EncodeInteger(int64(group.Pending.Len())),
+		resp.EncodeBulkString("last-delivered-id"), resp.EncodeBulkString(group.LastDelivered.String()),
+		resp.EncodeBulkString("entries-read"), encodeEntriesRead(group.EntriesRead),
+		resp.EncodeBulkString("lag"), encodeLag(lag, lagKnown),
+	})
+}
+
+// encodeOptionalStreamEntry encodes an entry, or nil for a missing one
+func encodeOptionalStreamEntry(entry *store.StreamEntry) []byte {
+	if entry == nil {
+		return resp.EncodeNullBulkString()
+	}
+	return encodeStreamEntry(entry)
+}
+
+// encodeEntriesRead encodes the read counter of a group, which is nil when unknown
+func encodeEntriesRead(entriesRead int64) []byte {
+	if entriesRead == store.UnknownEntriesRead {
+		return resp.EncodeNullBulkString()
+	}
+	return resp.EncodeInteger(entriesRead)
+}
+
+// encodeLag encodes the lag of a group, which is nil when it cannot be told
+func encodeLag(lag int64, known bool) []byte {
+	if !known {
+		return resp.EncodeNullBulkString()
+	}
+	return resp.EncodeInteger(lag)
+}
+
+// firstPending returns the count pending entries with the smallest IDs (all when count is 0)
+func firstPending(pel *store.PendingList, count int) []*store.PendingEntry {
+	pending := []*store.PendingEntry{}
+	pel.Range(store.StreamID{}, func(p *store.PendingEntry) bool {
+		pending = append(pending, p)
+		return count == 0 || len(pending) < count
+	})
+	return pending
+}
@@ -0,0 +1,30 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+type XLenCommand Command
+
+func (cmd *XLenCommand) Execute(con *client.Client) RESPValue {
+	// XLEN key
+	if len(cmd.args) != 1 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	var reply RESPValue
+	store.Atomically(func(tx store.Tx) {
+		stream, ok := lookupStream(tx, cmd.args[0])
+		switch {
+		case !ok:
+			reply = resp.EncodeSimpleError(errWrongType)
+		case stream == nil:
+			reply = resp.EncodeInteger(0)
+		default:
+			reply = resp.EncodeInteger(int64(stream.Len()))
+		}
+	})
+	return reply
+}
@@ -0,0 +1,172 @@
+package command
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+type XPendingCommand Command
+
+func (cmd *XPendingCommand) Execute(con *client.Client) RESPValue {
+	// XPENDING key group [[IDLE min-idle-time] start end count [consumer]]
+	if len(cmd.args) < 2 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	key, groupName := cmd.args[0], cmd.args[1]
+	args := cmd.args[2:]
+
+	extended := len(args) > 0
+	var minIdle int64
+	var start, end store.StreamID
+	var count int
+	var consumerName string
+	if extended {
+		if strings.ToUpper(args[0]) == "IDLE" {
+			if len(args) < 2 {
+				return resp.EncodeSimpleError(errSyntax)
+			}
+			idle, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil || idle < 0 {
+				return resp.EncodeSimpleError(errNotInteger)
+			}
+			minIdle = idle
+			args = args[2:]
+		}
+		if len(args) < 3 || len(args) > 4 {
+			return resp.EncodeSimpleError(errSyntax)
+		}
+
+		var ok bool
+		if start, ok = parseStreamRangeBound(args[0], true); !ok {
+			return resp.EncodeSimpleError(invalidStreamID)
+		}
+		if end, ok = parseStreamRangeBound(args[1], false); !ok {
+			return resp.EncodeSimpleError(invalidStreamID)
+		}
+		n, err := strconv.Atoi(args[2])
+		if err != nil {
+			return resp.EncodeSimpleError(errNotInteger)
+		}
+		count = max(n, 0)
+		if len(args) == 4 {
+			consumerName = args[3]
+		}
+	}
+
+	var reply RESPValue
+	store.Atomically(func(tx store.Tx) {
+		stream, ok := lookupStream(tx, key)
+		if !ok {
+			reply = resp.EncodeSimpleError(errWrongType)
+			return
+		}
+		if stream == nil || stream.Group(groupName) == nil {
+			reply = resp.EncodeSimpleError(fmt.Sprintf("NOGROUP No such key '%s' or consumer group '%s'", key, groupName))
+			return
+		}
+		group := stream.Group(groupName)
+
+		if !extended {
+			reply = encodePendingSummary(group)
+			return
+		}
+
+		pel := group.Pending
+		if consumerName != "" {
+			consumer, exists := group.Consumers[consumerName]
+			if !exists {
+				reply = resp.EncodeArray([][]byte{})
+				return
+			}
+			pel = consumer.Pending
+		}
+
+		now := time.Now()
+		items := [][]byte{}
+		pel.Range(start, func(pending *store.PendingEntry) bool {
+			if len(items) == count || pending.ID.Compare(end) > 0 {
+				return false
+			}
+			idle := pending.Idle(now).Milliseconds()
+			if idle < minIdle {
+				return true
+			}
+			items = append(items, resp.EncodeArray([][]byte{
+				resp.EncodeBulkString(pending.ID.String()),
+				resp.EncodeBulkString(pending.Consumer.Name),
+				resp.EncodeInteger(idle),
+				resp.EncodeInteger(pending.DeliveryCount),
+			}))
+			return true
+		})
+		reply = resp.EncodeArray(items)
+	})
+	return reply
+}
+
+// encodePendingSummary encodes the summary form of XPENDING: the number of pending entries,
+// the smallest and greatest pending IDs, and how many entries each consumer has pending
+func encodePendingSummary(group *store.ConsumerGroup) RESPValue {
+	if group.Pending.Len() == 0 {
+		return resp.EncodeArray([][]byte{
+			resp.EncodeInteger(0),
+			resp.EncodeNullBulkString(),
+			resp.EncodeNullBulkString(),
+			resp.EncodeNullArray(),
+		})
+	}
+
+	consumers := [][]byte{}
+	for _, name := range group.ConsumerNames() {
+		owned := group.Consumers[name].Pending.Len()
+		if owned == 0 {
+			continue
+		}
+		consumers = append(consumers, resp.EncodeArray([][]byte{
+			resp.EncodeBulkString(name),
+			resp.EncodeBulkString(strconv.Itoa(owned)),
+		}))
+	}
+
+	return resp.EncodeArray([][]byte{
+		resp.EncodeInteger(int64(group.Pending.Len())),
+		resp.EncodeBulkString(group.Pending.First().ID.String()),
+		resp.EncodeBulkString(group.Pending.Last().ID.String()),
+		resp.EncodeArray(consumers),
+	})
+}
+
+// parseStreamRangeBound parses the start or end of a range of stream IDs
+// - and + stand for the smallest and greatest IDs, a bare ms covers its whole millisecond,
+// and a leading ( excludes the ID itself.
+func parseStreamRangeBound(arg string, isStart bool) (store.StreamID, bool) {
+	switch arg {
+	case "-":
+		return store.StreamID{}, true
+	case "+":
+		return store.MaxStreamID, true
+	}
+
+	exclusive := strings.HasPrefix(arg, "(")
+	defaultSeq := uint64(0)
+	if !isStart {
+		defaultSeq = math.MaxUint64
+	}
+	id, ok := store.ParseStreamID(strings.TrimPrefix(arg, "("), defaultSeq)
+	if !ok || !exclusive {
+		return id, ok
+	}
+
+	if isStart {
+		return id.Next()
+	}
+	return id.Prev()
+}
@@ -1,7 +1,7 @@
 package command
 
 import (
-	"math"
+	"errors"
 	"strconv"
 	"strings"
 
@@ -13,128 +13,85 @@ import (
 type XRangeCommand Command
 
 func (cmd *XRangeCommand) Execute(con *client.Client) RESPValue {
-	if len(cmd.args) != 3 {
+	// XRANGE key start end [COUNT count]
+	if len(cmd.args) != 3 && len(cmd.args) != 5 {
 		return resp.EncodeSimpleError(errWrongNumberOfArgs)
 	}
 
 	key := cmd.args[0]
-	startID := cmd.args[1]
-	endID := cmd.args[2]
-
-	// Get stream
-	val, exists := store.Get(key)
-	if !exists || val.StreamData == nil {
-		return resp.EncodeArray([][]byte{})
-	}
-
-	stream := val.StreamData
 
 	// Parse start and end IDs
-	startTimestamp, startSequence := parseStreamID(startID, true)
-	endTimestamp, endSequence := parseStreamID(endID, false)
-
-	// Filter entries within range
-	var results [][]byte
-	for _, entry := range stream.Entries {
-		entryTimestamp, entrySequence := parseEntryID(entry.Id)
-
-		// Check if entry is within range
-		if isInRange(entryTimestamp, entrySequence, startTimestamp, startSequence, endTimestamp, endSequence) {
-			results = append(results, encodeStreamEntry(entry))
-		}
-	}
-
-	return resp.EncodeArray(results)
-}
-
-// parseStreamID parses a stream ID string and returns timestamp and sequence
-// isStart indicates if this is the start ID (for default sequence handling)
-func parseStreamID(id string, isStart bool) (int64, int64) {
-	// Handle special cases
-	if id == "-" {
-		return 0, 0
+	start, ok := parseStreamRangeBound(cmd.args[1], true)
+	if !ok {
+		return resp.EncodeSimpleError(invalidStreamID)
 	}
-	if id == "+" {
-		return math.MaxInt64, math.MaxInt64
-	}
-
-	parts := strings.Split(id, "-")
-	if len(parts) == 0 {
-		return 0, 0
+	end, ok := parseStreamRangeBound(cmd.args[2], false)
+	if !ok {
+		return resp.EncodeSimpleError(invalidStreamID)
 	}
 
-	timestamp, err := strconv.ParseInt(parts[0], 10, 64)
+	count, err := parseStreamCount(cmd.args[3:])
 	if err != nil {
-		return 0, 0
+		return resp.EncodeSimpleError(err.Error())
+	}
+	if count < 0 {
+		return resp.EncodeArray([][]byte{})
 	}
 
-	var sequence int64
-	if len(parts) == 1 {
-		// No sequence number provided
-		if isStart {
-			sequence = 0 // Start defaults to 0
-		} else {
-			sequence = math.MaxInt64 // End defaults to max
+	var results [][]byte
+	wrongType := false
+	store.Atomically(func(tx store.Tx) {
+		stream, ok := lookupStream(tx, key)
+		if !ok {
+			wrongType = true
+			return
 		}
-	} else {
-		sequence, err = strconv.ParseInt(parts[1], 10, 64)
-		if err != nil {
-			sequence = 0
+		if stream == nil {
+			return
 		}
-	}
-
-	return timestamp, sequence
-}
-
-// parseEntryID parses an entry's ID into timestamp and sequence
-func parseEntryID(id string) (int64, int64) {
-	parts := strings.Split(id, "-")
-	if len(parts) != 2 {
-		return 0, 0
-	}
 
-	timestamp, err1 := strconv.ParseInt(parts[0], 10, 64)
-	sequence, err2 := strconv.ParseInt(parts[1], 10, 64)
+		// Seek the first entry within range
+		for _, entry := range stream.Range(start, end, count) {
+			results = append(results, encodeStreamEntry(entry))
+		}
+	})
 
-	if err1 != nil || err2 != nil {
-		return 0, 0
+	if wrongType {
+		return resp.EncodeSimpleError(errWrongType)
 	}
-
-	return timestamp, sequence
+	return resp.EncodeArray(results)
 }
 
-// isInRange checks if an entry is within the specified range
-func isInRange(entryTS, entrySeq, startTS, startSeq, endTS, endSeq int64) bool {
-	// Check if entry >= start
-	if entryTS < startTS {
-		return false
+// parseStreamCount parses an optional trailing COUNT count of XRANGE and XREVRANGE
+// Returns 0 when no COUNT is given, and -1 for a COUNT that allows no entries.
+func parseStreamCount(args []string) (int, error) {
+	if len(args) == 0 {
+		return 0, nil
 	}
-	if entryTS == startTS && entrySeq < startSeq {
-		return false
+	if strings.ToUpper(args[0]) != "COUNT" {
+		return 0, errors.New(errSyntax)
 	}
-
-	// Check if entry <= end
-	if entryTS > endTS {
-		return false
+	count, err := strconv.Atoi(args[1])
+	if err != nil {
+		return 0, errors.New(errNotInteger)
 	}
-	if entryTS == endTS && entrySeq > endSeq {
-		return false
+	if count <= 0 {
+		return -1, nil
 	}
-
-	return true
+	return count, nil
 }
 
 // encodeStreamEntry encodes a stream entry as a RESP array
 func encodeStreamEntry(entry *store.StreamEntry) []byte {
 	// Entry is encoded as [ID, [field1, value1, field2, value2, ...]]
 	fields := make([][]byte, 0, len(entry.Fields)*2)
-	for key, val := range entry.Fields {
-		fields = append(fields, resp.EncodeBulkString(key))
-		fields = append(fields, resp.EncodeBulkString(val))
+	for _, field := range entry.Fields {
+		fields = append(fields, resp.EncodeBulkString(field.Name))
+		fields = append(fields, resp.EncodeBulkString(field.Value))
 	}
 
 	return resp.EncodeArray([][]byte{
-		resp.EncodeBulkString(entry.Id),
+		resp.EncodeBulkString(entry.ID.String()),
 		resp.EncodeArray(fields),
 	})
 }
@@ -14,23 +14,32 @@ import (
 type XReadCommand Command
 
 func (cmd *XReadCommand) Execute(con *client.Client) RESPValue {
+	// XREAD [COUNT count] [BLOCK milliseconds] STREAMS key [key ...] id [id ...]
 	// Minimum args: STREAMS key id (3 args after command name)
 	if len(cmd.args) < 3 {
 		return resp.EncodeSimpleError(errWrongNumberOfArgs)
 	}
 
-	// Parse BLOCK option if present
+	// Parse COUNT and BLOCK options if present
 	var blockTimeout int64 = -1 // -1 means no blocking
+	count := 0                  // 0 means no limit
 	argsToProcess := cmd.args
 
-	// Check for BLOCK keyword
-	if len(cmd.args) >= 2 && strings.ToUpper(cmd.args[0]) == "BLOCK" {
-		timeout, err := strconv.ParseInt(cmd.args[1], 10, 64)
+	for len(argsToProcess) >= 2 {
+		option := strings.ToUpper(argsToProcess[0])
+		if option != "BLOCK" && option != "COUNT" {
+			break
+		}
+		n, err := strconv.ParseInt(argsToProcess[1], 10, 64)
 		if err != nil {
 			return resp.EncodeSimpleError(errSyntax)
 		}
-		blockTimeout = timeout
-		argsToProcess = cmd.args[2:] // Skip BLOCK and timeout
+		if option == "BLOCK" {
+			blockTimeout = n
+		} else {
+			count = int(max(n, 0))
+		}
+		argsToProcess = argsToProcess[2:] // Skip the option and its value
 	}
 
 	// Find STREAMS keyword
@@ -56,27 +65,32 @@ func (cmd *XReadCommand) Execute(con *client.Client) RESPValue {
 	keys := argsAfterStreams[:numStreams]
 	ids := argsAfterStreams[numStreams:]
 
-	// If using $, replace with actual last IDs for blocking
-	resolvedIDs := make([]string, len(ids))
+	// Resolve $ to the last ID of each stream, so that blocking waits for entries added after the call
+	resolvedIDs := make([]store.StreamID, len(ids))
 	for i, id := range ids {
 		if id == "$" {
-			val, exists := store.Get(keys[i])
-			if exists && val.StreamData != nil && len(val.StreamData.Entries) > 0 {
-				resolvedIDs[i] = val.StreamData.LastID
-			} else {
-				resolvedIDs[i] = "0-0"
-			}
-		} else {
-			resolvedIDs[i] = id
+			store.Atomically(func(tx store.Tx) {
+				if stream, _ := lookupStream(tx, keys[i]); stream != nil {
+					resolvedIDs[i] = stream.LastID
+				}
+			})
+			continue
+		}
+
+		// A bare millisecond time reads the entries of later milliseconds
+		parsed, ok := store.ParseStreamID(id, math.MaxUint64)
+		if !ok {
+			return resp.EncodeSimpleError(invalidStreamID)
 		}
+		resolvedIDs[i] = parsed
 	}
 
 	// Try to read entries
-	results := readStreams(keys, resolvedIDs)
+	results := readStreams(keys, resolvedIDs, count)
 
 	// If blocking and no results, wait for new entries
 	if blockTimeout >= 0 && len(results) == 0 {
-		return blockAndWait(keys, resolvedIDs, blockTimeout)
+		return blockAndWait(keys, resolvedIDs, count, blockTimeout)
 	}
 
 	// Return null if no entries found in any stream
@@ -87,49 +101,37 @@ func (cmd *XReadCommand) Execute(con *client.Client) RESPValue {
 	return resp.EncodeArray(results)
 }
 
-// readStreams reads entries from multiple streams
-func readStreams(keys []string, ids []string) [][]byte {
+// readStreams reads at most count entries (all when count is 0) after the given ID of each stream
+func readStreams(keys []string, ids []store.StreamID, count int) [][]byte {
 	var results [][]byte
-	for i := 0; i < len(keys); i++ {
-		streamKey := keys[i]
-		startID := ids[i]
-
-		// Get stream
-		val, exists := store.Get(streamKey)
-		if !exists || val.StreamData == nil {
-			continue // Skip non-existent streams
-		}
-
-		stream := val.StreamData
-
-		// Parse start ID (exclusive - we want entries AFTER this ID)
-		startTimestamp, startSequence := parseStreamIDForXRead(startID)
-
-		// Collect entries after the start ID
-		var entries [][]byte
-		for _, entry := range stream.Entries {
-			entryTimestamp, entrySequence := parseEntryID(entry.Id)
+	store.Atomically(func(tx store.Tx) {
+		for i, streamKey := range keys {
+			stream, _ := lookupStream(tx, streamKey)
+			if stream == nil {
+				continue // Skip non-existent streams
+			}
 
-			// Check if entry is AFTER start ID (exclusive)
-			if isAfterID(entryTimestamp, entrySequence, startTimestamp, startSequence) {
+			// Collect entries after the start ID (exclusive)
+			var entries [][]byte
+			for _, entry := range stream.After(ids[i], count) {
 				entries = append(entries, encodeStreamEntry(entry))
 			}
-		}
 
-		// Only include stream in results if it has entries
-		if len(entries) > 0 {
-			streamResult := resp.EncodeArray([][]byte{
-				resp.EncodeBulkString(streamKey),
-				resp.EncodeArray(entries),
-			})
-			results = append(results, streamResult)
+			// Only include stream in results if it has entries
+			if len(entries) > 0 {
+				streamResult := resp.EncodeArray([][]byte{
+					resp.EncodeBulkString(streamKey),
+					resp.EncodeArray(entries),
+				})
+				results = append(results, streamResult)
+			}
 		}
-	}
+	})
 	return results
 }
 
 // blockAndWait blocks and waits for new entries in the specified streams
-func blockAndWait(keys []string, ids []string, timeoutMs int64) RESPValue {
+func blockAndWait(keys []string, ids []store.StreamID, count int, timeoutMs int64) RESPValue {
 	// Calculate end time for timeout
 	var endTime time.Time
 	hasTimeout := timeoutMs > 0
@@ -148,7 +150,7 @@ func blockAndWait(keys []string, ids []string, timeoutMs int64) RESPValue {
 
 	for {
 		// Check for new entries
-		results := readStreams(keys, ids)
+		results := readStreams(keys, ids, count)
 		if len(results) > 0 {
 			return resp.EncodeArray(results)
 		}
@@ -165,47 +167,3 @@ func blockAndWait(keys []string, ids []string, timeoutMs int64) RESPValue {
 		// For blocking with timeout, the timeout check above will handle it
 	}
 }
-
-// parseStreamIDForXRead parses a stream ID for XREAD (similar to parseStreamID but always exclusive)
-func parseStreamIDForXRead(id string) (int64, int64) {
-	// Handle special case for $
-	if id == "$" {
-		// $ means the ID of the last entry in the stream
-		// This will be handled by the caller
-		return math.MaxInt64, math.MaxInt64
-	}
-
-	parts := strings.Split(id, "-")
-	if len(parts) == 0 {
-		return 0, 0
-	}
-
-	timestamp, err := strconv.ParseInt(parts[0], 10, 64)
-	if err != nil {
-		return 0, 0
-	}
-
-	var sequence int64
-	if len(parts) == 1 {
-		// No sequence number provided - default to max to get entries with higher timestamps
-		sequence = math.MaxInt64
-	} else {
-		sequence, err = strconv.ParseInt(parts[1], 10, 64)
-		if err != nil {
-			sequence = 0
-		}
-	}
-
-	return timestamp, sequence
-}
-
-// isAfterID checks if an entry comes after the specified ID (exclusive comparison)
-func isAfterID(entryTS, entrySeq, afterTS, afterSeq int64) bool {
-	if entryTS > afterTS {
-		return true
-	}
-	if entryTS == afterTS && entrySeq > afterSeq {
-		return true
-	}
-	return false
-}
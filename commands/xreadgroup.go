@@ -0,0 +1,202 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+type XReadGroupCommand Command
+
+// xreadGroupRequest is a parsed XREADGROUP command
+type xreadGroupRequest struct {
+	group    string
+	consumer string
+	count    int
+	block    int64 // milliseconds, -1 when not blocking
+	noAck    bool
+	keys     []string
+	ids      []string // ">" for new entries, or the ID after which to read the consumer's history
+}
+
+func (cmd *XReadGroupCommand) Execute(con *client.Client) RESPValue {
+	// XREADGROUP GROUP group consumer [COUNT count] [BLOCK milliseconds] [NOACK] STREAMS key [key ...] id [id ...]
+	req, err := parseXReadGroupArgs(cmd.args)
+	if err != nil {
+		return resp.EncodeSimpleError(err.Error())
+	}
+
+	results, reply := xreadGroup(req)
+	if reply != nil {
+		return reply
+	}
+
+	// Only reads of new entries block, and only while none arrive
+	if results == nil && req.block >= 0 {
+		var deadline time.Time
+		if req.block > 0 {
+			deadline = time.Now().Add(time.Duration(req.block) * time.Millisecond)
+		}
+		for results == nil && reply == nil && (deadline.IsZero() || time.Now().Before(deadline)) {
+			time.Sleep(10 * time.Millisecond)
+			results, reply = xreadGroup(req)
+		}
+		if reply != nil {
+			return reply
+		}
+	}
+
+	if results == nil {
+		return resp.EncodeNullArray()
+	}
+	return resp.EncodeArray(results)
+}
+
+// parseXReadGroupArgs parses the arguments of XREADGROUP
+func parseXReadGroupArgs(args []string) (xreadGroupRequest, error) {
+	req := xreadGroupRequest{block: -1}
+	if len(args) < 6 || strings.ToUpper(args[0]) != "GROUP" {
+		return req, errors.New(errWrongNumberOfArgs)
+	}
+	req.group, req.consumer = args[1], args[2]
+
+	i := 3
+	for ; i < len(args); i++ {
+		option := strings.ToUpper(args[i])
+		if option == "STREAMS" {
+			break
+		}
+		switch {
+		case option == "COUNT" && i+1 < len(args):
+			count, err := strconv.Atoi(args[i+1])
+			if err != nil || count < 0 {
+				return req, errors.New(errNotInteger)
+			}
+			req.count = count
+			i++
+		case option == "BLOCK" && i+1 < len(args):
+			block, err := strconv.ParseInt(args[i+1], 10, 64)
+			if err != nil || block < 0 {
+				return req, errors.New("ERR timeout is not an integer or out of range")
+			}
+			req.block = block
+			i++
+		case option == "NOACK":
+			req.noAck = true
+		default:
+			return req, errors.New(errSyntax)
+		}
+	}
+
+	streams := args[min(i+1, len(args)):]
+	if len(streams) == 0 || len(streams)%2 != 0 {
+		return req, errors.New("ERR Unbalanced 'xreadgroup' list of streams: for each stream key an ID or '>' must be specified.")
+	}
+	req.keys = streams[:len(streams)/2]
+	req.ids = streams[len(streams)/2:]
+
+	for _, id := range req.ids {
+		if id == ">" {
+			continue
+		}
+		if _, ok := store.ParseStreamID(id, 0); !ok {
+			return req, errors.New(invalidStreamID)
+		}
+		// History reads return immediately
+		req.block = -1
+	}
+
+	return req, nil
+}
+
+// xreadGroup reads each stream once for the group's consumer, returning the per-stream
+// results, nil when there was nothing to return, or an error reply
+// New entries are marked as delivered, and history reads count as another delivery.
+func xreadGroup(req xreadGroupRequest) ([][]byte, RESPValue) {
+	var results [][]byte
+	var reply RESPValue
+
+	store.Atomically(func(tx store.Tx) {
+		now := time.Now()
+
+		// Check every stream before touching any group
+		groups := make([]*store.ConsumerGroup, len(req.keys))
+		streams := make([]*store.Stream, len(req.keys))
+		for i, key := range req.keys {
+			stream, ok := lookupStream(tx, key)
+			if !ok {
+				reply = resp.EncodeSimpleError(errWrongType)
+				return
+			}
+			if stream == nil || stream.Group(req.group) == nil {
+				reply = resp.EncodeSimpleError(fmt.Sprintf("NOGROUP No such key '%s' or consumer group '%s' in XREADGROUP with GROUP option", key, req.group))
+				return
+			}
+			streams[i], groups[i] = stream, stream.Group(req.group)
+		}
+
+		for i, key := range req.keys {
+			group := groups[i]
+			consumer := group.Consumer(req.consumer, now)
+			consumer.SeenAt = now
+
+			var entries [][]byte
+			if req.ids[i] == ">" {
+				for _, entry := range streams[i].After(group.LastDelivered, req.count) {
+					streams[i].MarkRead(group, entry.ID)
+					if !req.noAck {
+						group.Deliver(consumer, entry.ID, now)
+					}
+					entries = append(entries, encodeStreamEntry(entry))
+				}
+				if len(entries) == 0 {
+					continue
+				}
+			} else {
+				after, _ := store.ParseStreamID(req.ids[i], 0)
+				entries = readConsumerHistory(streams[i], group, consumer, after, req.count, now)
+			}
+
+			results = append(results, resp.EncodeArray([][]byte{
+				resp.EncodeBulkString(key),
+				resp.EncodeArray(entries),
+			}))
+		}
+	})
+
+	return results, reply
+}
+
+// readConsumerHistory returns the entries pending for consumer with IDs greater than after,
+// counting the read as another delivery of each
+// Entries deleted from the stream since their delivery are returned with nil fields.
+func readConsumerHistory(stream *store.Stream, group *store.ConsumerGroup, consumer *store.Consumer, after store.StreamID, count int, now time.Time) [][]byte {
+	entries := [][]byte{}
+	start, ok := after.Next()
+	if !ok {
+		return entries
+	}
+	consumer.Pending.Range(start, func(pending *store.PendingEntry) bool {
+		if count > 0 && len(entries) == count {
+			return false
+		}
+
+		group.Deliver(consumer, pending.ID, now)
+		if entry := stream.Lookup(pending.ID); entry != nil {
+			entries = append(entries, encodeStreamEntry(entry))
+		} else {
+			entries = append(entries, resp.EncodeArray([][]byte{
+				resp.EncodeBulkString(pending.ID.String()),
+				resp.EncodeNullArray(),
+			}))
+		}
+		return true
+	})
+	return entries
+}
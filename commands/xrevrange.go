@@ -0,0 +1,59 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+type XRevRangeCommand Command
+
+func (cmd *XRevRangeCommand) Execute(con *client.Client) RESPValue {
+	// XREVRANGE key end start [COUNT count]
+	if len(cmd.args) != 3 && len(cmd.args) != 5 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	key := cmd.args[0]
+
+	// Parse end and start IDs, given in that order
+	end, ok := parseStreamRangeBound(cmd.args[1], false)
+	if !ok {
+		return resp.EncodeSimpleError(invalidStreamID)
+	}
+	start, ok := parseStreamRangeBound(cmd.args[2], true)
+	if !ok {
+		return resp.EncodeSimpleError(invalidStreamID)
+	}
+
+	count, err := parseStreamCount(cmd.args[3:])
+	if err != nil {
+		return resp.EncodeSimpleError(err.Error())
+	}
+	if count < 0 {
+		return resp.EncodeArray([][]byte{})
+	}
+
+	var results [][]byte
+	wrongType := false
+	store.Atomically(func(tx store.Tx) {
+		stream, ok := lookupStream(tx, key)
+		if !ok {
+			wrongType = true
+			return
+		}
+		if stream == nil {
+			return
+		}
+
+		// Seek the newest entry within range and walk back
+		for _, entry := range stream.RevRange(start, end, count) {
+			results = append(results, encodeStreamEntry(entry))
+		}
+	})
+
+	if wrongType {
+		return resp.EncodeSimpleError(errWrongType)
+	}
+	return resp.EncodeArray(results)
+}
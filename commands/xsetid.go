@@ -0,0 +1,89 @@
+package command
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+type XSetIDCommand Command
+
+func (cmd *XSetIDCommand) Execute(con *client.Client) RESPValue {
+	// XSETID key last-id [ENTRIESADDED entries-added] [MAXDELETEDID max-deleted-id]
+	if len(cmd.args) < 2 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	key := cmd.args[0]
+	lastID, ok := store.ParseStreamID(cmd.args[1], 0)
+	if !ok {
+		return resp.EncodeSimpleError(invalidStreamID)
+	}
+
+	entriesAdded := int64(-1)
+	var maxDeletedID *store.StreamID
+	for i := 2; i < len(cmd.args); i += 2 {
+		if i+1 >= len(cmd.args) {
+			return resp.EncodeSimpleError(errSyntax)
+		}
+		switch strings.ToUpper(cmd.args[i]) {
+		case "ENTRIESADDED":
+			n, err := strconv.ParseInt(cmd.args[i+1], 10, 64)
+			if err != nil {
+				return resp.EncodeSimpleError(errNotInteger)
+			}
+			if n < 0 {
+				return resp.EncodeSimpleError("ERR entries_added must be positive")
+			}
+			entriesAdded = n
+		case "MAXDELETEDID":
+			id, ok := store.ParseStreamID(cmd.args[i+1], 0)
+			if !ok {
+				return resp.EncodeSimpleError(invalidStreamID)
+			}
+			maxDeletedID = &id
+		default:
+			return resp.EncodeSimpleError(errSyntax)
+		}
+	}
+
+	if maxDeletedID != nil && lastID.Compare(*maxDeletedID) < 0 {
+		return resp.EncodeSimpleError("ERR The ID specified in XSETID is smaller than the provided max_deleted_entry_id")
+	}
+
+	var reply RESPValue
+	store.Atomically(func(tx store.Tx) {
+		stream, ok := lookupStream(tx, key)
+		if !ok {
+			reply = resp.EncodeSimpleError(errWrongType)
+			return
+		}
+		if stream == nil {
+			reply = resp.EncodeSimpleError("ERR no such key")
+			return
+		}
+
+		// The last ID can only move past the entries the stream holds
+		if last := stream.Last(); last != nil && lastID.Compare(last.ID) < 0 {
+			reply = resp.EncodeSimpleError("ERR The ID specified in XSETID is smaller than the target stream top item")
+			return
+		}
+		if entriesAdded >= 0 && entriesAdded < int64(stream.Len()) {
+			reply = resp.EncodeSimpleError("ERR The entries_added specified in XSETID is smaller than the target stream length")
+			return
+		}
+
+		stream.LastID = lastID
+		if entriesAdded >= 0 {
+			stream.EntriesAdded = entriesAdded
+		}
+		if maxDeletedID != nil {
+			stream.MaxDeletedID = *maxDeletedID
+		}
+		reply = resp.Success()
+	})
+	return reply
+}
@@ -0,0 +1,135 @@
+package command
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+type XTrimCommand Command
+
+const (
+	errTrimStrategies = "ERR syntax error, MAXLEN and MINID options at the same time are not compatible"
+	errTrimMaxLen     = "ERR The MAXLEN argument must be >= 0."
+	errTrimLimit      = "ERR syntax error, LIMIT cannot be used without the special ~ option"
+)
+
+// streamTrim is a parsed MAXLEN|MINID [=|~] threshold [LIMIT count] trimming strategy
+type streamTrim struct {
+	byMinID bool
+	maxLen  int
+	minID   store.StreamID
+	approx  bool
+	limit   int // how many entries one trim may remove at most, 0 for no limit
+}
+
+func (cmd *XTrimCommand) Execute(con *client.Client) RESPValue {
+	// XTRIM key MAXLEN|MINID [=|~] threshold [LIMIT count]
+	if len(cmd.args) < 3 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	key := cmd.args[0]
+	trim, n, err := parseStreamTrim(cmd.args[1:])
+	if err != nil {
+		return resp.EncodeSimpleError(err.Error())
+	}
+	if n == 0 || 1+n != len(cmd.args) {
+		return resp.EncodeSimpleError(errSyntax)
+	}
+
+	var reply RESPValue
+	store.Atomically(func(tx store.Tx) {
+		stream, ok := lookupStream(tx, key)
+		if !ok {
+			reply = resp.EncodeSimpleError(errWrongType)
+			return
+		}
+		if stream == nil {
+			reply = resp.EncodeInteger(0)
+			return
+		}
+		reply = resp.EncodeInteger(int64(trim.apply(stream)))
+	})
+	return reply
+}
+
+// parseStreamTrim parses a trimming strategy at the start of args, returning how many arguments
+// it took (0 if args does not start with MAXLEN or MINID)
+func parseStreamTrim(args []string) (streamTrim, int, error) {
+	var trim streamTrim
+	if len(args) == 0 {
+		return trim, 0, nil
+	}
+
+	switch strings.ToUpper(args[0]) {
+	case "MAXLEN":
+	case "MINID":
+		trim.byMinID = true
+	default:
+		return trim, 0, nil
+	}
+
+	i := 1
+	if i < len(args) && (args[i] == "=" || args[i] == "~") {
+		trim.approx = args[i] == "~"
+		i++
+	}
+	if i >= len(args) {
+		return trim, 0, errors.New(errSyntax)
+	}
+
+	if trim.byMinID {
+		id, ok := store.ParseStreamID(args[i], 0)
+		if !ok {
+			return trim, 0, errors.New(invalidStreamID)
+		}
+		trim.minID = id
+	} else {
+		maxLen, err := strconv.Atoi(args[i])
+		if err != nil {
+			return trim, 0, errors.New(errNotInteger)
+		}
+		if maxLen < 0 {
+			return trim, 0, errors.New(errTrimMaxLen)
+		}
+		trim.maxLen = maxLen
+	}
+	i++
+
+	// Approximate trims are bounded by default so that one call never stalls the server
+	if trim.approx {
+		trim.limit = 100 * store.StreamNodeSize
+	}
+	if i+1 < len(args) && strings.ToUpper(args[i]) == "LIMIT" {
+		if !trim.approx {
+			return trim, 0, errors.New(errTrimLimit)
+		}
+		limit, err := strconv.Atoi(args[i+1])
+		if err != nil || limit < 0 {
+			return trim, 0, errors.New(errNotInteger)
+		}
+		trim.limit = limit
+		i += 2
+	}
+
+	if i < len(args) {
+		if next := strings.ToUpper(args[i]); next == "MAXLEN" || next == "MINID" {
+			return trim, 0, errors.New(errTrimStrategies)
+		}
+	}
+
+	return trim, i, nil
+}
+
+// apply trims the stream, returning how many entries were removed
+func (t streamTrim) apply(stream *store.Stream) int {
+	if t.byMinID {
+		return stream.TrimMinID(t.minID, t.approx, t.limit)
+	}
+	return stream.TrimMaxLen(t.maxLen, t.approx, t.limit)
+}
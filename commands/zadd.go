@@ -1,7 +1,10 @@
 package command
 
 import (
+	"errors"
+	"math"
 	"strconv"
+	"strings"
 
 	"github.com/SuchintK/GoDisKV/resp"
 	"github.com/SuchintK/GoDisKV/resp/client"
@@ -10,57 +13,152 @@ import (
 
 type ZAddCommand Command
 
-func (cmd *ZAddCommand) Execute(con *client.Client) RESPValue {
-	numArgs := len(cmd.args)
+// zaddFlags holds the options of ZADD
+type zaddFlags struct {
+	nx   bool // only add new members
+	xx   bool // only update existing members
+	gt   bool // only update when the new score is greater
+	lt   bool // only update when the new score is lower
+	ch   bool // count changed members as well as added ones
+	incr bool // increment the score instead of setting it
+}
 
-	// ZADD key score member [score member ...]
-	if numArgs < 3 || (numArgs-1)%2 != 0 {
+func (cmd *ZAddCommand) Execute(con *client.Client) RESPValue {
+	// ZADD key [NX|XX] [GT|LT] [CH] [INCR] score member [score member ...]
+	if len(cmd.args) < 3 {
 		return resp.EncodeSimpleError(errWrongNumberOfArgs)
 	}
 
 	key := cmd.args[0]
 
-	// Get or create sorted set
-	val, exists := store.Get(key)
-	var zset *store.SortedSet
-
-	if exists {
-		if val.SortedSetData != nil {
-			zset = val.SortedSetData
-		} else if val.Data != "" || val.StreamData != nil {
-			// Key exists but is not a sorted set
-			return resp.EncodeSimpleError("WRONGTYPE Operation against a key holding the wrong kind of value")
-		} else {
-			// Empty value, create new sorted set
-			zset = store.NewSortedSet()
-			val.SortedSetData = zset
-			store.Set(key, val)
+	// Options come before the first score
+	var flags zaddFlags
+	i := 1
+parseFlags:
+	for ; i < len(cmd.args); i++ {
+		switch strings.ToUpper(cmd.args[i]) {
+		case "NX":
+			flags.nx = true
+		case "XX":
+			flags.xx = true
+		case "GT":
+			flags.gt = true
+		case "LT":
+			flags.lt = true
+		case "CH":
+			flags.ch = true
+		case "INCR":
+			flags.incr = true
+		default:
+			break parseFlags
 		}
-	} else {
-		// Create new sorted set
-		zset = store.NewSortedSet()
-		store.Set(key, &store.Value{
-			SortedSetData: zset,
-		})
 	}
 
-	// Parse and add score-member pairs
-	added := 0
-	for i := 1; i < numArgs; i += 2 {
-		scoreStr := cmd.args[i]
-		member := cmd.args[i+1]
+	pairs := cmd.args[i:]
+	if len(pairs) == 0 || len(pairs)%2 != 0 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+	if flags.nx && flags.xx {
+		return resp.EncodeSimpleError("ERR XX and NX options at the same time are not compatible")
+	}
+	if (flags.gt && flags.lt) || (flags.nx && (flags.gt || flags.lt)) {
+		return resp.EncodeSimpleError("ERR GT, LT, and/or NX options at the same time are not compatible")
+	}
+	if flags.incr && len(pairs) != 2 {
+		return resp.EncodeSimpleError("ERR INCR option supports a single increment-element pair")
+	}
 
-		// Parse score
-		score, err := strconv.ParseFloat(scoreStr, 64)
+	return zaddMembers(key, flags, pairs)
+}
+
+// zaddMembers implements ZADD and ZINCRBY once the options are validated
+// pairs alternates scores and members. With INCR the reply is the new score, or a null
+// bulk string when the flags prevented the update; otherwise it is the number of members
+// added (plus updated with CH).
+func zaddMembers(key string, flags zaddFlags, pairs []string) RESPValue {
+	// Validate every score before touching the set
+	scores := make([]float64, 0, len(pairs)/2)
+	for i := 0; i < len(pairs); i += 2 {
+		score, err := parseScore(pairs[i])
 		if err != nil {
-			return resp.EncodeSimpleError("ERR value is not a valid float")
+			return resp.EncodeSimpleError(err.Error())
 		}
+		scores = append(scores, score)
+	}
 
-		// Add to sorted set
-		if zset.Add(score, member) {
+	zset, ok := lookupSortedSet(key)
+	if !ok {
+		return resp.EncodeSimpleError(errWrongType)
+	}
+	created := zset == nil
+	if created {
+		zset = store.NewSortedSet()
+	}
+
+	added, changed := 0, 0
+	var incrResult RESPValue = resp.EncodeNullBulkString()
+	for i, score := range scores {
+		member := pairs[2*i+1]
+		current, exists := zset.GetScore(member)
+
+		if (exists && flags.nx) || (!exists && flags.xx) {
+			continue
+		}
+
+		if flags.incr && exists {
+			score += current
+			if math.IsNaN(score) {
+				return resp.EncodeSimpleError("ERR resulting score is not a number (NaN)")
+			}
+		}
+
+		if exists && ((flags.gt && score <= current) || (flags.lt && score >= current)) {
+			continue
+		}
+
+		zset.Add(score, member)
+		if !exists {
 			added++
+		} else if score != current {
+			changed++
 		}
+		if flags.incr {
+			incrResult = resp.EncodeBulkString(formatScore(score))
+		}
+	}
+
+	// Only store a new sorted set if something was added to it
+	if created && zset.Card() > 0 {
+		store.Set(key, &store.Value{SortedSetData: zset})
 	}
 
+	if flags.incr {
+		return incrResult
+	}
+	if flags.ch {
+		return resp.EncodeInteger(int64(added + changed))
+	}
 	return resp.EncodeInteger(int64(added))
 }
+
+// parseScore parses a sorted set score, accepting -inf/+inf but not NaN
+func parseScore(s string) (float64, error) {
+	score, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsNaN(score) {
+		return 0, errors.New(errNotFloat)
+	}
+	return score, nil
+}
+
+// lookupSortedSet returns the sorted set stored at key, or nil if the key does not exist
+// ok is false when the key holds a value of another type
+func lookupSortedSet(key string) (*store.SortedSet, bool) {
+	val, exists := store.Get(key)
+	if !exists {
+		return nil, true
+	}
+	if val.SortedSetData == nil {
+		return nil, false
+	}
+	return val.SortedSetData, true
+}
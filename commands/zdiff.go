@@ -0,0 +1,12 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp/client"
+)
+
+type ZDiffCommand Command
+
+func (cmd *ZDiffCommand) Execute(con *client.Client) RESPValue {
+	// ZDIFF numkeys key [key ...] [WITHSCORES]
+	return zsetAlgebraCommand(cmd.label, setOpDiff, cmd.args)
+}
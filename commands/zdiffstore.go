@@ -0,0 +1,12 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp/client"
+)
+
+type ZDiffStoreCommand Command
+
+func (cmd *ZDiffStoreCommand) Execute(con *client.Client) RESPValue {
+	// ZDIFFSTORE destination numkeys key [key ...]
+	return storeZSetAlgebra(cmd.label, setOpDiff, cmd.args)
+}
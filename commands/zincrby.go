@@ -0,0 +1,18 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+)
+
+type ZIncrByCommand Command
+
+func (cmd *ZIncrByCommand) Execute(con *client.Client) RESPValue {
+	// ZINCRBY key increment member
+	if len(cmd.args) != 3 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	// Same as ZADD key INCR increment member
+	return zaddMembers(cmd.args[0], zaddFlags{incr: true}, cmd.args[1:])
+}
@@ -0,0 +1,12 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp/client"
+)
+
+type ZInterCommand Command
+
+func (cmd *ZInterCommand) Execute(con *client.Client) RESPValue {
+	// ZINTER numkeys key [key ...] [WEIGHTS weight [weight ...]] [AGGREGATE SUM|MIN|MAX] [WITHSCORES]
+	return zsetAlgebraCommand(cmd.label, setOpInter, cmd.args)
+}
@@ -0,0 +1,75 @@
+package command
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+)
+
+type ZInterCardCommand Command
+
+func (cmd *ZInterCardCommand) Execute(con *client.Client) RESPValue {
+	// ZINTERCARD numkeys key [key ...] [LIMIT limit]
+	if len(cmd.args) < 2 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	numKeys, err := strconv.Atoi(cmd.args[0])
+	if err != nil {
+		return resp.EncodeSimpleError(errNotInteger)
+	}
+	if numKeys <= 0 {
+		return resp.EncodeSimpleError("ERR numkeys should be greater than 0")
+	}
+	if numKeys > len(cmd.args)-1 {
+		return resp.EncodeSimpleError(errSyntax)
+	}
+
+	keys := cmd.args[1 : 1+numKeys]
+	limit := 0
+	rest := cmd.args[1+numKeys:]
+	switch {
+	case len(rest) == 0:
+	case len(rest) == 2 && strings.ToUpper(rest[0]) == "LIMIT":
+		limit, err = strconv.Atoi(rest[1])
+		if err != nil {
+			return resp.EncodeSimpleError(errNotInteger)
+		}
+		if limit < 0 {
+			return resp.EncodeSimpleError("ERR LIMIT can't be negative")
+		}
+	default:
+		return resp.EncodeSimpleError(errSyntax)
+	}
+
+	inputs := make([]map[string]float64, len(keys))
+	for i, key := range keys {
+		scores, err := loadZSetInput(key)
+		if err != nil {
+			return resp.EncodeSimpleError(err.Error())
+		}
+		inputs[i] = scores
+	}
+
+	// Count members of the first input found in all the others, stopping at the limit
+	count := 0
+	for member := range inputs[0] {
+		inAll := true
+		for _, input := range inputs[1:] {
+			if _, exists := input[member]; !exists {
+				inAll = false
+				break
+			}
+		}
+		if inAll {
+			count++
+			if limit > 0 && count == limit {
+				break
+			}
+		}
+	}
+
+	return resp.EncodeInteger(int64(count))
+}
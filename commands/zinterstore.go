@@ -0,0 +1,12 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp/client"
+)
+
+type ZInterStoreCommand Command
+
+func (cmd *ZInterStoreCommand) Execute(con *client.Client) RESPValue {
+	// ZINTERSTORE destination numkeys key [key ...] [WEIGHTS weight [weight ...]] [AGGREGATE SUM|MIN|MAX]
+	return storeZSetAlgebra(cmd.label, setOpInter, cmd.args)
+}
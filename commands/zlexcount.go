@@ -0,0 +1,30 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+)
+
+type ZLexCountCommand Command
+
+func (cmd *ZLexCountCommand) Execute(con *client.Client) RESPValue {
+	// ZLEXCOUNT key min max
+	if len(cmd.args) != 3 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	min, max, err := parseLexRange(cmd.args[1], cmd.args[2], false)
+	if err != nil {
+		return resp.EncodeSimpleError(err.Error())
+	}
+
+	zset, ok := lookupSortedSet(cmd.args[0])
+	if !ok {
+		return resp.EncodeSimpleError(errWrongType)
+	}
+	if zset == nil {
+		return resp.EncodeInteger(0)
+	}
+
+	return resp.EncodeInteger(int64(zset.CountByLex(min, max)))
+}
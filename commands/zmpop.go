@@ -0,0 +1,41 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+type ZMPopCommand Command
+
+func (cmd *ZMPopCommand) Execute(con *client.Client) RESPValue {
+	// ZMPOP numkeys key [key ...] MIN|MAX [COUNT count]
+	req, err := parseMultiPopArgs(cmd.args, parseZSetWhere)
+	if err != nil {
+		return resp.EncodeSimpleError(err.Error())
+	}
+
+	for _, key := range req.keys {
+		items, ok := zsetPop(key, req.fromHead, req.count)
+		if !ok {
+			return resp.EncodeSimpleError(errWrongType)
+		}
+		if len(items) > 0 {
+			return encodeZMPopReply(key, items)
+		}
+	}
+
+	return resp.EncodeNullArray()
+}
+
+// encodeZMPopReply encodes the [key, [[member, score] ...]] reply of ZMPOP and BZMPOP
+func encodeZMPopReply(key string, items []store.ScoredMember) RESPValue {
+	pairs := make([][]byte, len(items))
+	for i, item := range items {
+		pairs[i] = resp.EncodeArrayBulk(item.Member, formatScore(item.Score))
+	}
+	return resp.EncodeArray([][]byte{
+		resp.EncodeBulkString(key),
+		resp.EncodeArray(pairs),
+	})
+}
@@ -0,0 +1,34 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+)
+
+type ZMScoreCommand Command
+
+func (cmd *ZMScoreCommand) Execute(con *client.Client) RESPValue {
+	// ZMSCORE key member [member ...]
+	if len(cmd.args) < 2 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	zset, ok := lookupSortedSet(cmd.args[0])
+	if !ok {
+		return resp.EncodeSimpleError(errWrongType)
+	}
+
+	members := cmd.args[1:]
+	result := make([][]byte, len(members))
+	for i, member := range members {
+		result[i] = resp.EncodeNullBulkString()
+		if zset == nil {
+			continue
+		}
+		if score, exists := zset.GetScore(member); exists {
+			result[i] = resp.EncodeBulkString(formatScore(score))
+		}
+	}
+
+	return resp.EncodeArray(result)
+}
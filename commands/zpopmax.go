@@ -0,0 +1,12 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp/client"
+)
+
+type ZPopMaxCommand Command
+
+func (cmd *ZPopMaxCommand) Execute(con *client.Client) RESPValue {
+	// ZPOPMAX key [count]
+	return zsetPopCommand(cmd.args, false)
+}
@@ -0,0 +1,92 @@
+package command
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+type ZPopMinCommand Command
+
+func (cmd *ZPopMinCommand) Execute(con *client.Client) RESPValue {
+	// ZPOPMIN key [count]
+	return zsetPopCommand(cmd.args, true)
+}
+
+// zsetPopCommand implements ZPOPMIN and ZPOPMAX
+func zsetPopCommand(args []string, min bool) RESPValue {
+	if len(args) < 1 || len(args) > 2 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	count := 1
+	if len(args) == 2 {
+		n, err := strconv.Atoi(args[1])
+		if err != nil {
+			return resp.EncodeSimpleError(errNotInteger)
+		}
+		if n < 0 {
+			return resp.EncodeSimpleError("ERR value is out of range, must be positive")
+		}
+		count = n
+	}
+
+	items, ok := zsetPop(args[0], min, count)
+	if !ok {
+		return resp.EncodeSimpleError(errWrongType)
+	}
+
+	return encodeScoredMembers(items, true)
+}
+
+// zsetPop atomically removes up to count members with the lowest (or highest) scores
+// from the sorted set at key, deleting the key once it is empty
+// ok is false when the key holds a value of another type.
+func zsetPop(key string, min bool, count int) (items []store.ScoredMember, ok bool) {
+	ok = true
+	store.Atomically(func(tx store.Tx) {
+		val, exists := tx.Get(key)
+		if !exists {
+			return
+		}
+		if val.SortedSetData == nil {
+			ok = false
+			return
+		}
+
+		if min {
+			items = val.SortedSetData.PopMin(count)
+		} else {
+			items = val.SortedSetData.PopMax(count)
+		}
+
+		if val.SortedSetData.Card() == 0 {
+			tx.Delete(key)
+		}
+	})
+
+	return items, ok
+}
+
+// parseZSetWhere parses MIN or MAX, returning true for MIN
+func parseZSetWhere(s string) (bool, bool) {
+	switch strings.ToUpper(s) {
+	case "MIN":
+		return true, true
+	case "MAX":
+		return false, true
+	default:
+		return false, false
+	}
+}
+
+// zsetWhere is the inverse of parseZSetWhere, used when rewriting commands for replicas
+func zsetWhere(min bool) string {
+	if min {
+		return "min"
+	}
+	return "max"
+}
@@ -0,0 +1,69 @@
+package command
+
+import (
+	"math/rand"
+	"strings"
+
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+type ZRandMemberCommand Command
+
+func (cmd *ZRandMemberCommand) Execute(con *client.Client) RESPValue {
+	// ZRANDMEMBER key [count [WITHSCORES]]
+	if len(cmd.args) < 1 || len(cmd.args) > 3 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	withScores := false
+	if len(cmd.args) == 3 {
+		if strings.ToUpper(cmd.args[2]) != "WITHSCORES" {
+			return resp.EncodeSimpleError(errSyntax)
+		}
+		withScores = true
+	}
+
+	zset, ok := lookupSortedSet(cmd.args[0])
+	if !ok {
+		return resp.EncodeSimpleError(errWrongType)
+	}
+
+	// Without count a single member (or nil) is returned
+	if len(cmd.args) == 1 {
+		if zset == nil {
+			return resp.EncodeNullBulkString()
+		}
+		// Seek a random rank instead of copying the whole set
+		rank := rand.Intn(zset.Card())
+		return resp.EncodeBulkString(zset.GetRange(rank, rank)[0])
+	}
+
+	count, err := parseRandomCount(cmd.args[1])
+	if err != nil {
+		return resp.EncodeSimpleError(err.Error())
+	}
+	if zset == nil || count == 0 {
+		return resp.EncodeArray([][]byte{})
+	}
+
+	items := zset.GetRangeWithScores(0, -1)
+	if count > 0 {
+		// Positive count: distinct members, at most the size of the set
+		rand.Shuffle(len(items), func(i, j int) {
+			items[i], items[j] = items[j], items[i]
+		})
+		if count < len(items) {
+			items = items[:count]
+		}
+		return encodeScoredMembers(items, withScores)
+	}
+
+	// Negative count: the same member may be returned multiple times
+	picked := make([]store.ScoredMember, -count)
+	for i := range picked {
+		picked[i] = items[rand.Intn(len(items))]
+	}
+	return encodeScoredMembers(picked, withScores)
+}
@@ -1,7 +1,10 @@
 package command
 
 import (
+	"errors"
+	"math"
 	"strconv"
+	"strings"
 
 	"github.com/SuchintK/GoDisKV/resp"
 	"github.com/SuchintK/GoDisKV/resp/client"
@@ -10,57 +13,254 @@ import (
 
 type ZRangeCommand Command
 
-func (cmd *ZRangeCommand) Execute(con *client.Client) RESPValue {
-	numArgs := len(cmd.args)
+// zrangeBy selects how the start and stop arguments of a range query are interpreted
+type zrangeBy int
+
+const (
+	zrangeByRank zrangeBy = iota
+	zrangeByScore
+	zrangeByLex
+)
+
+// zrangeQuery is a parsed ZRANGE-style range query
+// With rev the first bound is the upper one, as in ZREVRANGEBYSCORE key max min.
+type zrangeQuery struct {
+	by         zrangeBy
+	start      string
+	stop       string
+	rev        bool
+	offset     int
+	count      int // -1 when no LIMIT was given
+	withScores bool
+}
+
+var (
+	errMinMaxNotFloat  = errors.New("ERR min or max is not a float")
+	errMinMaxNotString = errors.New("ERR min or max not valid string range item")
+)
 
-	// ZRANGE key start stop [WITHSCORES]
-	if numArgs < 3 {
+func (cmd *ZRangeCommand) Execute(con *client.Client) RESPValue {
+	// ZRANGE key start stop [BYSCORE|BYLEX] [REV] [LIMIT offset count] [WITHSCORES]
+	if len(cmd.args) < 3 {
 		return resp.EncodeSimpleError(errWrongNumberOfArgs)
 	}
 
-	key := cmd.args[0]
-	startStr := cmd.args[1]
-	stopStr := cmd.args[2]
-	withScores := false
+	query, err := parseZRangeQuery(zrangeQuery{start: cmd.args[1], stop: cmd.args[2]}, cmd.args[3:], true)
+	if err != nil {
+		return resp.EncodeSimpleError(err.Error())
+	}
+
+	return execZRangeQuery(cmd.args[0], query)
+}
+
+// parseZRangeQuery parses the options following "key start stop" into query, which holds
+// the bounds and any mode implied by the command name
+func parseZRangeQuery(query zrangeQuery, options []string, allowWithScores bool) (zrangeQuery, error) {
+	query.count = -1
+	hasLimit := false
+
+	for i := 0; i < len(options); i++ {
+		switch strings.ToUpper(options[i]) {
+		case "BYSCORE":
+			query.by = zrangeByScore
+		case "BYLEX":
+			query.by = zrangeByLex
+		case "REV":
+			query.rev = true
+		case "WITHSCORES":
+			if !allowWithScores {
+				return query, errors.New(errSyntax)
+			}
+			query.withScores = true
+		case "LIMIT":
+			if i+2 >= len(options) {
+				return query, errors.New(errSyntax)
+			}
+			offset, err := strconv.Atoi(options[i+1])
+			if err != nil {
+				return query, errors.New(errNotInteger)
+			}
+			count, err := strconv.Atoi(options[i+2])
+			if err != nil {
+				return query, errors.New(errNotInteger)
+			}
+			query.offset, query.count = offset, count
+			hasLimit = true
+			i += 2
+		default:
+			return query, errors.New(errSyntax)
+		}
+	}
+
+	if hasLimit && query.by == zrangeByRank {
+		return query, errors.New("ERR syntax error, LIMIT is only supported in combination with either BYSCORE or BYLEX")
+	}
+	if query.withScores && query.by == zrangeByLex {
+		return query, errors.New("ERR syntax error, WITHSCORES not supported in combination with BYLEX")
+	}
+	// Any negative count means no limit
+	if query.count < 0 {
+		query.count = -1
+	}
+
+	return query, nil
+}
+
+// parseZRangeByQuery parses the options of ZRANGEBYSCORE, ZRANGEBYLEX and their REV forms,
+// which take the mode from the command name and only accept LIMIT and WITHSCORES
+func parseZRangeByQuery(by zrangeBy, rev bool, start, stop string, options []string) (zrangeQuery, error) {
+	for _, option := range options {
+		switch strings.ToUpper(option) {
+		case "BYSCORE", "BYLEX", "REV":
+			return zrangeQuery{}, errors.New(errSyntax)
+		}
+	}
+	return parseZRangeQuery(zrangeQuery{by: by, rev: rev, start: start, stop: stop}, options, true)
+}
+
+// execZRangeQuery runs query against the sorted set at key and encodes the reply
+func execZRangeQuery(key string, query zrangeQuery) RESPValue {
+	zset, ok := lookupSortedSet(key)
+	if !ok {
+		return resp.EncodeSimpleError(errWrongType)
+	}
+
+	items, err := query.run(zset)
+	if err != nil {
+		return resp.EncodeSimpleError(err.Error())
+	}
+
+	return encodeScoredMembers(items, query.withScores)
+}
 
-	if numArgs >= 4 && cmd.args[3] == "withscores" {
-		withScores = true
+// run evaluates the query against zset, which may be nil for a missing key
+// Bounds are validated even when there is nothing to range over.
+func (q zrangeQuery) run(zset *store.SortedSet) ([]store.ScoredMember, error) {
+	switch q.by {
+	case zrangeByScore:
+		min, max, err := parseScoreRange(q.start, q.stop, q.rev)
+		if err != nil {
+			return nil, err
+		}
+		if zset == nil || q.offset < 0 {
+			return []store.ScoredMember{}, nil
+		}
+		return zset.RangeByScore(min, max, q.rev, q.offset, q.count), nil
+
+	case zrangeByLex:
+		min, max, err := parseLexRange(q.start, q.stop, q.rev)
+		if err != nil {
+			return nil, err
+		}
+		if zset == nil || q.offset < 0 {
+			return []store.ScoredMember{}, nil
+		}
+		return zset.RangeByLex(min, max, q.rev, q.offset, q.count), nil
+
+	default:
+		start, err := strconv.Atoi(q.start)
+		if err != nil {
+			return nil, errors.New(errNotInteger)
+		}
+		stop, err := strconv.Atoi(q.stop)
+		if err != nil {
+			return nil, errors.New(errNotInteger)
+		}
+		if zset == nil {
+			return []store.ScoredMember{}, nil
+		}
+		return rangeByRank(zset, start, stop, q.rev), nil
 	}
+}
 
-	// Parse start and stop
-	start, err := strconv.Atoi(startStr)
+// rangeByRank returns the members between ranks start and stop, which may be negative
+// With rev, ranks count from the highest score down.
+func rangeByRank(zset *store.SortedSet, start, stop int, rev bool) []store.ScoredMember {
+	if rev {
+		return zset.GetRevRangeWithScores(start, stop)
+	}
+	return zset.GetRangeWithScores(start, stop)
+}
+
+// parseScoreRange parses the two bounds of a score query, given as max first with rev
+func parseScoreRange(start, stop string, rev bool) (store.ScoreBound, store.ScoreBound, error) {
+	if rev {
+		start, stop = stop, start
+	}
+	min, err := parseScoreBound(start)
 	if err != nil {
-		return resp.EncodeSimpleError("ERR value is not an integer or out of range")
+		return min, min, err
 	}
-	stop, err := strconv.Atoi(stopStr)
+	max, err := parseScoreBound(stop)
+	return min, max, err
+}
+
+// parseLexRange parses the two bounds of a lexicographic query, given as max first with rev
+func parseLexRange(start, stop string, rev bool) (store.LexBound, store.LexBound, error) {
+	if rev {
+		start, stop = stop, start
+	}
+	min, err := parseLexBound(start)
 	if err != nil {
-		return resp.EncodeSimpleError("ERR value is not an integer or out of range")
+		return min, min, err
 	}
+	max, err := parseLexBound(stop)
+	return min, max, err
+}
 
-	// Get sorted set
-	val, exists := store.Get(key)
-	if !exists || val.SortedSetData == nil {
-		return resp.EncodeArray([][]byte{})
+// parseScoreBound parses a score range bound: a float, optionally prefixed by "(" to make
+// it exclusive, or -inf/+inf
+func parseScoreBound(s string) (store.ScoreBound, error) {
+	bound := store.ScoreBound{}
+	if strings.HasPrefix(s, "(") {
+		bound.Exclusive = true
+		s = s[1:]
 	}
+	value, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsNaN(value) {
+		return bound, errMinMaxNotFloat
+	}
+	bound.Value = value
+	return bound, nil
+}
 
-	zset := val.SortedSetData
+// parseLexBound parses a lexicographic range bound: "[" (inclusive) or "(" (exclusive)
+// followed by a member, or "-"/"+" for the start and end of the set
+func parseLexBound(s string) (store.LexBound, error) {
+	switch {
+	case s == "-":
+		return store.LexBound{Infinite: -1}, nil
+	case s == "+":
+		return store.LexBound{Infinite: 1}, nil
+	case strings.HasPrefix(s, "["):
+		return store.LexBound{Value: s[1:]}, nil
+	case strings.HasPrefix(s, "("):
+		return store.LexBound{Value: s[1:], Exclusive: true}, nil
+	default:
+		return store.LexBound{}, errMinMaxNotString
+	}
+}
 
-	if withScores {
-		// Return with scores
-		rangeWithScores := zset.GetRangeWithScores(start, stop)
-		result := make([][]byte, 0, len(rangeWithScores)*2)
-		for _, item := range rangeWithScores {
-			result = append(result, resp.EncodeBulkString(item.Member))
-			result = append(result, resp.EncodeBulkString(strconv.FormatFloat(item.Score, 'f', -1, 64)))
+// encodeScoredMembers encodes members, each followed by its score when withScores is set
+func encodeScoredMembers(items []store.ScoredMember, withScores bool) RESPValue {
+	result := make([][]byte, 0, len(items)*2)
+	for _, item := range items {
+		result = append(result, resp.EncodeBulkString(item.Member))
+		if withScores {
+			result = append(result, resp.EncodeBulkString(formatScore(item.Score)))
 		}
-		return resp.EncodeArray(result)
 	}
+	return resp.EncodeArray(result)
+}
 
-	// Return only members
-	members := zset.GetRange(start, stop)
-	result := make([][]byte, len(members))
-	for i, member := range members {
-		result[i] = resp.EncodeBulkString(member)
+// formatScore formats a score the way Redis replies with it, e.g. "1.5", "inf" or "-inf"
+func formatScore(score float64) string {
+	switch {
+	case math.IsInf(score, 1):
+		return "inf"
+	case math.IsInf(score, -1):
+		return "-inf"
+	default:
+		return strconv.FormatFloat(score, 'f', -1, 64)
 	}
-	return resp.EncodeArray(result)
 }
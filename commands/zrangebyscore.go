@@ -0,0 +1,22 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+)
+
+type ZRangeByScoreCommand Command
+
+func (cmd *ZRangeByScoreCommand) Execute(con *client.Client) RESPValue {
+	// ZRANGEBYSCORE key min max [WITHSCORES] [LIMIT offset count]
+	if len(cmd.args) < 3 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	query, err := parseZRangeByQuery(zrangeByScore, false, cmd.args[1], cmd.args[2], cmd.args[3:])
+	if err != nil {
+		return resp.EncodeSimpleError(err.Error())
+	}
+
+	return execZRangeQuery(cmd.args[0], query)
+}
@@ -0,0 +1,46 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+type ZRangeStoreCommand Command
+
+func (cmd *ZRangeStoreCommand) Execute(con *client.Client) RESPValue {
+	// ZRANGESTORE destination source start stop [BYSCORE|BYLEX] [REV] [LIMIT offset count]
+	if len(cmd.args) < 4 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	destination := cmd.args[0]
+	query, err := parseZRangeQuery(zrangeQuery{start: cmd.args[2], stop: cmd.args[3]}, cmd.args[4:], false)
+	if err != nil {
+		return resp.EncodeSimpleError(err.Error())
+	}
+
+	zset, ok := lookupSortedSet(cmd.args[1])
+	if !ok {
+		return resp.EncodeSimpleError(errWrongType)
+	}
+
+	items, err := query.run(zset)
+	if err != nil {
+		return resp.EncodeSimpleError(err.Error())
+	}
+
+	// An empty result removes the destination, like any emptied sorted set
+	if len(items) == 0 {
+		store.Delete(destination)
+		return resp.EncodeInteger(0)
+	}
+
+	result := store.NewSortedSet()
+	for _, item := range items {
+		result.Add(item.Score, item.Member)
+	}
+	store.Set(destination, &store.Value{SortedSetData: result})
+
+	return resp.EncodeInteger(int64(result.Card()))
+}
@@ -1,31 +1,65 @@
 package command
 
 import (
+	"strings"
+
 	"github.com/SuchintK/GoDisKV/resp"
 	"github.com/SuchintK/GoDisKV/resp/client"
-	"github.com/SuchintK/GoDisKV/store"
 )
 
 type ZRankCommand Command
 
 func (cmd *ZRankCommand) Execute(con *client.Client) RESPValue {
-	if len(cmd.args) != 2 {
+	// ZRANK key member [WITHSCORE]
+	return zsetRank(cmd.args, false)
+}
+
+// zsetRank implements ZRANK and ZREVRANK, whose ranks count from the highest score down
+func zsetRank(args []string, rev bool) RESPValue {
+	if len(args) < 2 || len(args) > 3 {
 		return resp.EncodeSimpleError(errWrongNumberOfArgs)
 	}
 
-	key := cmd.args[0]
-	member := cmd.args[1]
+	withScore := false
+	if len(args) == 3 {
+		if strings.ToUpper(args[2]) != "WITHSCORE" {
+			return resp.EncodeSimpleError(errSyntax)
+		}
+		withScore = true
+	}
 
-	// Get sorted set
-	val, exists := store.Get(key)
-	if !exists || val.SortedSetData == nil {
-		return resp.EncodeNullBulkString()
+	// A missing member is a null bulk string, or a null array with WITHSCORE
+	notFound := resp.EncodeNullBulkString()
+	if withScore {
+		notFound = resp.EncodeNullArray()
 	}
 
-	rank := val.SortedSetData.GetRank(member)
+	key := args[0]
+	member := args[1]
+
+	zset, ok := lookupSortedSet(key)
+	if !ok {
+		return resp.EncodeSimpleError(errWrongType)
+	}
+	if zset == nil {
+		return notFound
+	}
+
+	rank := zset.GetRank(member)
 	if rank == -1 {
-		return resp.EncodeNullBulkString()
+		return notFound
+	}
+	if rev {
+		rank = zset.Card() - 1 - rank
+	}
+
+	if !withScore {
+		return resp.EncodeInteger(int64(rank))
 	}
 
-	return resp.EncodeInteger(int64(rank))
+	score, _ := zset.GetScore(member)
+	return resp.EncodeArray([][]byte{
+		resp.EncodeInteger(int64(rank)),
+		resp.EncodeBulkString(formatScore(score)),
+	})
 }
@@ -0,0 +1,12 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp/client"
+)
+
+type ZRemRangeByLexCommand Command
+
+func (cmd *ZRemRangeByLexCommand) Execute(con *client.Client) RESPValue {
+	// ZREMRANGEBYLEX key min max
+	return zsetRemoveRange(cmd.args, zrangeByLex)
+}
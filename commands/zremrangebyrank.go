@@ -0,0 +1,44 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+type ZRemRangeByRankCommand Command
+
+func (cmd *ZRemRangeByRankCommand) Execute(con *client.Client) RESPValue {
+	// ZREMRANGEBYRANK key start stop
+	return zsetRemoveRange(cmd.args, zrangeByRank)
+}
+
+// zsetRemoveRange implements ZREMRANGEBYRANK, ZREMRANGEBYSCORE and ZREMRANGEBYLEX,
+// removing the members a ZRANGE query of the same kind would return
+func zsetRemoveRange(args []string, by zrangeBy) RESPValue {
+	if len(args) != 3 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	key := args[0]
+	query := zrangeQuery{by: by, start: args[1], stop: args[2], count: -1}
+
+	zset, ok := lookupSortedSet(key)
+	if !ok {
+		return resp.EncodeSimpleError(errWrongType)
+	}
+
+	items, err := query.run(zset)
+	if err != nil {
+		return resp.EncodeSimpleError(err.Error())
+	}
+
+	for _, item := range items {
+		zset.Remove(item.Member)
+	}
+	if zset != nil && zset.Card() == 0 {
+		store.Delete(key)
+	}
+
+	return resp.EncodeInteger(int64(len(items)))
+}
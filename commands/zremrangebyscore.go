@@ -0,0 +1,12 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp/client"
+)
+
+type ZRemRangeByScoreCommand Command
+
+func (cmd *ZRemRangeByScoreCommand) Execute(con *client.Client) RESPValue {
+	// ZREMRANGEBYSCORE key min max
+	return zsetRemoveRange(cmd.args, zrangeByScore)
+}
@@ -0,0 +1,22 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+)
+
+type ZRevRangeByScoreCommand Command
+
+func (cmd *ZRevRangeByScoreCommand) Execute(con *client.Client) RESPValue {
+	// ZREVRANGEBYSCORE key max min [WITHSCORES] [LIMIT offset count]
+	if len(cmd.args) < 3 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	query, err := parseZRangeByQuery(zrangeByScore, true, cmd.args[1], cmd.args[2], cmd.args[3:])
+	if err != nil {
+		return resp.EncodeSimpleError(err.Error())
+	}
+
+	return execZRangeQuery(cmd.args[0], query)
+}
@@ -0,0 +1,12 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp/client"
+)
+
+type ZRevRankCommand Command
+
+func (cmd *ZRevRankCommand) Execute(con *client.Client) RESPValue {
+	// ZREVRANK key member [WITHSCORE]
+	return zsetRank(cmd.args, true)
+}
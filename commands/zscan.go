@@ -0,0 +1,39 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+)
+
+type ZScanCommand Command
+
+func (cmd *ZScanCommand) Execute(con *client.Client) RESPValue {
+	// ZSCAN key cursor [MATCH pattern] [COUNT count]
+	if len(cmd.args) < 2 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	opts, err := parseScanOptions(cmd.args[1:], false)
+	if err != nil {
+		return resp.EncodeSimpleError(err.Error())
+	}
+
+	zset, ok := lookupSortedSet(cmd.args[0])
+	if !ok {
+		return resp.EncodeSimpleError(errWrongType)
+	}
+	if zset == nil {
+		return encodeScanReply(0, [][]byte{})
+	}
+
+	// Members start in score order; a score update does not move a member in the scan
+	next, members := scanOrdered(zset.ScanOrder(), opts)
+
+	items := make([][]byte, 0, len(members)*2)
+	for _, member := range members {
+		score, _ := zset.GetScore(member)
+		items = append(items, resp.EncodeBulkString(member), resp.EncodeBulkString(formatScore(score)))
+	}
+
+	return encodeScanReply(next, items)
+}
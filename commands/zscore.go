@@ -1,8 +1,6 @@
 package command
 
 import (
-	"strconv"
-
 	"github.com/SuchintK/GoDisKV/resp"
 	"github.com/SuchintK/GoDisKV/resp/client"
 	"github.com/SuchintK/GoDisKV/store"
@@ -29,5 +27,5 @@ func (cmd *ZScoreCommand) Execute(con *client.Client) RESPValue {
 		return resp.EncodeNullBulkString()
 	}
 
-	return resp.EncodeBulkString(strconv.FormatFloat(score, 'f', -1, 64))
+	return resp.EncodeBulkString(formatScore(score))
 }
@@ -0,0 +1,270 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+type ZUnionCommand Command
+
+// Score aggregation functions for ZUNION and ZINTER
+const (
+	zsetAggregateSum = iota
+	zsetAggregateMin
+	zsetAggregateMax
+)
+
+// zsetAlgebraRequest holds the parsed arguments of ZUNION, ZINTER, ZDIFF and their STORE variants
+type zsetAlgebraRequest struct {
+	keys       []string
+	weights    []float64
+	aggregate  int
+	withScores bool
+}
+
+func (cmd *ZUnionCommand) Execute(con *client.Client) RESPValue {
+	// ZUNION numkeys key [key ...] [WEIGHTS weight [weight ...]] [AGGREGATE SUM|MIN|MAX] [WITHSCORES]
+	return zsetAlgebraCommand(cmd.label, setOpUnion, cmd.args)
+}
+
+// zsetAlgebraCommand implements ZUNION, ZINTER and ZDIFF
+func zsetAlgebraCommand(label string, op int, args []string) RESPValue {
+	req, err := parseZSetAlgebraArgs(label, op, args, true)
+	if err != nil {
+		return resp.EncodeSimpleError(err.Error())
+	}
+
+	result, err := zsetAlgebra(op, req)
+	if err != nil {
+		return resp.EncodeSimpleError(err.Error())
+	}
+
+	return encodeScoredMembers(result.GetRangeWithScores(0, -1), req.withScores)
+}
+
+// storeZSetAlgebra implements the *STORE variants: the result overwrites destination,
+// which is deleted when the result is empty. Returns the cardinality of the result.
+func storeZSetAlgebra(label string, op int, args []string) RESPValue {
+	if len(args) < 3 {
+		return resp.EncodeSimpleError(errWrongNumberOfArgs)
+	}
+
+	destination := args[0]
+	req, err := parseZSetAlgebraArgs(label, op, args[1:], false)
+	if err != nil {
+		return resp.EncodeSimpleError(err.Error())
+	}
+
+	result, err := zsetAlgebra(op, req)
+	if err != nil {
+		return resp.EncodeSimpleError(err.Error())
+	}
+
+	if result.Card() == 0 {
+		store.Delete(destination)
+	} else {
+		store.Set(destination, &store.Value{SortedSetData: result})
+	}
+
+	return resp.EncodeInteger(int64(result.Card()))
+}
+
+// parseZSetAlgebraArgs parses "numkeys key [key ...]" followed by the options of op
+// ZDIFF takes neither WEIGHTS nor AGGREGATE, and the STORE variants take no WITHSCORES.
+func parseZSetAlgebraArgs(label string, op int, args []string, allowWithScores bool) (zsetAlgebraRequest, error) {
+	var req zsetAlgebraRequest
+	if len(args) < 2 {
+		return req, errors.New(errWrongNumberOfArgs)
+	}
+
+	numKeys, err := strconv.Atoi(args[0])
+	if err != nil {
+		return req, errors.New(errNotInteger)
+	}
+	if numKeys <= 0 {
+		return req, fmt.Errorf("ERR at least 1 input key is needed for '%s' command", label)
+	}
+	// Compare without adding to numkeys, which may be as large as the integer range
+	if numKeys > len(args)-1 {
+		return req, errors.New(errSyntax)
+	}
+
+	req.keys = args[1 : 1+numKeys]
+	req.weights = make([]float64, numKeys)
+	for i := range req.weights {
+		req.weights[i] = 1
+	}
+
+	for i := 1 + numKeys; i < len(args); i++ {
+		switch option := strings.ToUpper(args[i]); {
+		case option == "WEIGHTS" && op != setOpDiff:
+			if i+numKeys >= len(args) {
+				return req, errors.New(errSyntax)
+			}
+			for j := range req.weights {
+				weight, err := strconv.ParseFloat(args[i+1+j], 64)
+				if err != nil || math.IsNaN(weight) {
+					return req, errors.New("ERR weight value is not a float")
+				}
+				req.weights[j] = weight
+			}
+			i += numKeys
+		case option == "AGGREGATE" && op != setOpDiff:
+			if i+1 >= len(args) {
+				return req, errors.New(errSyntax)
+			}
+			switch strings.ToUpper(args[i+1]) {
+			case "SUM":
+				req.aggregate = zsetAggregateSum
+			case "MIN":
+				req.aggregate = zsetAggregateMin
+			case "MAX":
+				req.aggregate = zsetAggregateMax
+			default:
+				return req, errors.New(errSyntax)
+			}
+			i++
+		case option == "WITHSCORES" && allowWithScores:
+			req.withScores = true
+		default:
+			return req, errors.New(errSyntax)
+		}
+	}
+
+	return req, nil
+}
+
+// loadZSetInput returns the member scores of the sorted set or set at key, or nil if the
+// key does not exist. Plain set members all have a score of 1.
+func loadZSetInput(key string) (map[string]float64, error) {
+	val, exists := store.Get(key)
+	if !exists {
+		return nil, nil
+	}
+
+	switch {
+	case val.SortedSetData != nil:
+		items := val.SortedSetData.GetRangeWithScores(0, -1)
+		scores := make(map[string]float64, len(items))
+		for _, item := range items {
+			scores[item.Member] = item.Score
+		}
+		return scores, nil
+	case val.SetData != nil:
+		members := val.SetData.Members()
+		scores := make(map[string]float64, len(members))
+		for _, member := range members {
+			scores[member] = 1
+		}
+		return scores, nil
+	default:
+		return nil, errSetWrongType
+	}
+}
+
+// zsetAlgebra computes the union, intersection or difference of the inputs of req
+// Scores are multiplied by the input's weight and combined with the aggregate function;
+// ZDIFF keeps the scores of the first input.
+func zsetAlgebra(op int, req zsetAlgebraRequest) (*store.SortedSet, error) {
+	inputs := make([]map[string]float64, len(req.keys))
+	for i, key := range req.keys {
+		scores, err := loadZSetInput(key)
+		if err != nil {
+			return nil, err
+		}
+		inputs[i] = scores
+	}
+
+	scores := make(map[string]float64)
+	switch op {
+	case setOpUnion:
+		for i, input := range inputs {
+			for member, score := range input {
+				weighted := weightedScore(score, req.weights[i])
+				if current, exists := scores[member]; exists {
+					scores[member] = aggregateScores(req.aggregate, current, weighted)
+				} else {
+					scores[member] = weighted
+				}
+			}
+		}
+	case setOpInter:
+		// Iterate the smallest input and probe the others
+		smallest := 0
+		for i, input := range inputs {
+			if len(input) < len(inputs[smallest]) {
+				smallest = i
+			}
+		}
+		for member := range inputs[smallest] {
+			inAll := true
+			for _, input := range inputs {
+				if _, exists := input[member]; !exists {
+					inAll = false
+					break
+				}
+			}
+			if !inAll {
+				continue
+			}
+			// Aggregate in key order so MIN/MAX/SUM see the inputs as given
+			score := weightedScore(inputs[0][member], req.weights[0])
+			for i := 1; i < len(inputs); i++ {
+				score = aggregateScores(req.aggregate, score, weightedScore(inputs[i][member], req.weights[i]))
+			}
+			scores[member] = score
+		}
+	case setOpDiff:
+		for member, score := range inputs[0] {
+			inOther := false
+			for _, input := range inputs[1:] {
+				if _, exists := input[member]; exists {
+					inOther = true
+					break
+				}
+			}
+			if !inOther {
+				scores[member] = score
+			}
+		}
+	}
+
+	result := store.NewSortedSet()
+	for member, score := range scores {
+		result.Add(score, member)
+	}
+	return result, nil
+}
+
+// weightedScore multiplies score by weight, treating the NaN of 0 * inf as 0 like Redis
+func weightedScore(score, weight float64) float64 {
+	weighted := score * weight
+	if math.IsNaN(weighted) {
+		return 0
+	}
+	return weighted
+}
+
+// aggregateScores combines two scores of the same member
+func aggregateScores(aggregate int, a, b float64) float64 {
+	switch aggregate {
+	case zsetAggregateMin:
+		return math.Min(a, b)
+	case zsetAggregateMax:
+		return math.Max(a, b)
+	default:
+		// inf + -inf is NaN, which Redis stores as 0
+		sum := a + b
+		if math.IsNaN(sum) {
+			return 0
+		}
+		return sum
+	}
+}
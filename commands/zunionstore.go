@@ -0,0 +1,12 @@
+package command
+
+import (
+	"github.com/SuchintK/GoDisKV/resp/client"
+)
+
+type ZUnionStoreCommand Command
+
+func (cmd *ZUnionStoreCommand) Execute(con *client.Client) RESPValue {
+	// ZUNIONSTORE destination numkeys key [key ...] [WEIGHTS weight [weight ...]] [AGGREGATE SUM|MIN|MAX]
+	return storeZSetAlgebra(cmd.label, setOpUnion, cmd.args)
+}
@@ -0,0 +1,211 @@
+package geofence
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"sync"
+
+	"github.com/SuchintK/GoDisKV/geohash"
+)
+
+// Events published when a member crosses a fence boundary
+const (
+	Enter = "enter"
+	Exit  = "exit"
+)
+
+// ErrTooFewVertices is returned when a polygon has fewer than three vertices
+var ErrTooFewVertices = errors.New("a polygon needs at least 3 vertices")
+
+// Point is a position on the map in degrees
+type Point struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// Fence is a region of the map that members of a geo key can enter or leave
+type Fence interface {
+	// Contains reports whether the point lies inside the fence
+	Contains(latitude, longitude float64) bool
+
+	// Bounds returns the area enclosing the fence, along with its center and the
+	// distance from the center to its farthest point, in meters
+	Bounds() (area geohash.Area, center Point, radiusMeters float64)
+}
+
+// Circle is a fence holding every point within RadiusMeters of Center
+type Circle struct {
+	Center       Point
+	RadiusMeters float64
+}
+
+func (c Circle) Contains(latitude, longitude float64) bool {
+	return geohash.Distance(c.Center.Latitude, c.Center.Longitude, latitude, longitude) <= c.RadiusMeters
+}
+
+func (c Circle) Bounds() (geohash.Area, Point, float64) {
+	return geohash.RadiusArea(c.Center.Latitude, c.Center.Longitude, c.RadiusMeters), c.Center, c.RadiusMeters
+}
+
+// Polygon is a fence enclosed by straight edges between consecutive vertices in
+// longitude/latitude space, closing back to the first vertex
+// Polygons are expected not to cross the antimeridian.
+type Polygon struct {
+	vertices []Point
+}
+
+// NewPolygon creates a polygon from its vertices in order
+func NewPolygon(vertices []Point) (*Polygon, error) {
+	if len(vertices) < 3 {
+		return nil, ErrTooFewVertices
+	}
+	return &Polygon{vertices: vertices}, nil
+}
+
+// Contains counts how many edges a ray cast east from the point crosses (even-odd rule)
+func (p *Polygon) Contains(latitude, longitude float64) bool {
+	inside := false
+	j := len(p.vertices) - 1
+	for i, a := range p.vertices {
+		b := p.vertices[j]
+		if (a.Latitude > latitude) != (b.Latitude > latitude) {
+			crossing := a.Longitude + (latitude-a.Latitude)*(b.Longitude-a.Longitude)/(b.Latitude-a.Latitude)
+			if longitude < crossing {
+				inside = !inside
+			}
+		}
+		j = i
+	}
+	return inside
+}
+
+func (p *Polygon) Bounds() (geohash.Area, Point, float64) {
+	area := geohash.Area{MinLat: 90, MaxLat: -90, MinLon: 180, MaxLon: -180}
+	for _, v := range p.vertices {
+		area.MinLat = math.Min(area.MinLat, v.Latitude)
+		area.MaxLat = math.Max(area.MaxLat, v.Latitude)
+		area.MinLon = math.Min(area.MinLon, v.Longitude)
+		area.MaxLon = math.Max(area.MaxLon, v.Longitude)
+	}
+
+	center := Point{Latitude: (area.MinLat + area.MaxLat) / 2, Longitude: (area.MinLon + area.MaxLon) / 2}
+	radius := 0.0
+	for _, v := range p.vertices {
+		radius = math.Max(radius, geohash.Distance(center.Latitude, center.Longitude, v.Latitude, v.Longitude))
+	}
+	return area, center, radius
+}
+
+// Crossing is a member entering or leaving a fence
+type Crossing struct {
+	Fence string
+	Event string
+}
+
+// Registry holds the fences registered on each geo key
+type Registry struct {
+	mu     sync.RWMutex
+	fences map[string]map[string]Fence // key -> fence name -> fence
+}
+
+var Global = NewRegistry()
+
+// NewRegistry creates an empty registry
+func NewRegistry() *Registry {
+	return &Registry{fences: make(map[string]map[string]Fence)}
+}
+
+// ResetGlobal resets the global registry (for testing)
+func ResetGlobal() {
+	Global = NewRegistry()
+}
+
+// Set registers fence under name on key, replacing any fence of the same name
+// Returns true if the name was not registered before
+func (r *Registry) Set(key, name string, fence Fence) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.fences[key] == nil {
+		r.fences[key] = make(map[string]Fence)
+	}
+	_, exists := r.fences[key][name]
+	r.fences[key][name] = fence
+	return !exists
+}
+
+// Delete removes the fence registered under name on key
+// Returns false if there was none
+func (r *Registry) Delete(key, name string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.fences[key][name]; !exists {
+		return false
+	}
+	delete(r.fences[key], name)
+	if len(r.fences[key]) == 0 {
+		delete(r.fences, key)
+	}
+	return true
+}
+
+// DeleteKey removes every fence registered on key
+func (r *Registry) DeleteKey(key string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.fences, key)
+}
+
+// Get returns the fence registered under name on key
+func (r *Registry) Get(key, name string) (Fence, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	fence, exists := r.fences[key][name]
+	return fence, exists
+}
+
+// Names returns the names of the fences registered on key, sorted
+func (r *Registry) Names(key string) []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	names := make([]string, 0, len(r.fences[key]))
+	for name := range r.fences[key] {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Crossings returns the fences of key that a member moving from one point to another enters
+// or leaves, sorted by fence name
+// from is nil for a member that was not on the map before.
+func (r *Registry) Crossings(key string, from *Point, to Point) []Crossing {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	crossings := []Crossing{}
+	for name, fence := range r.fences[key] {
+		wasInside := from != nil && fence.Contains(from.Latitude, from.Longitude)
+		isInside := fence.Contains(to.Latitude, to.Longitude)
+		switch {
+		case isInside && !wasInside:
+			crossings = append(crossings, Crossing{Fence: name, Event: Enter})
+		case wasInside && !isInside:
+			crossings = append(crossings, Crossing{Fence: name, Event: Exit})
+		}
+	}
+	sort.Slice(crossings, func(i, j int) bool {
+		return crossings[i].Fence < crossings[j].Fence
+	})
+	return crossings
+}
+
+// Channel returns the Pub/Sub channel carrying the events of a fence
+func Channel(key, name string) string {
+	return "geofence:" + key + ":" + name
+}
@@ -0,0 +1,70 @@
+package geohash
+
+import (
+	"errors"
+	"strings"
+)
+
+// base32Alphabet is the standard geohash alphabet, which leaves out a, i, l and o
+const base32Alphabet = "0123456789bcdefghjkmnpqrstuvwxyz"
+
+// base32Length is the length of the strings produced by EncodeBase32
+// 52 bits fill ten characters and two bits of the eleventh, which is padded with zeros.
+const base32Length = 11
+
+// ErrInvalidBase32 is returned when decoding a string with characters outside the geohash alphabet
+var ErrInvalidBase32 = errors.New("invalid geohash string")
+
+// EncodeBase32 returns the standard geohash string of a point
+// Standard geohashes span latitudes -90 to 90 rather than the Mercator limits used by
+// Encode, so the bits differ from the sorted-set score of the same point.
+func EncodeBase32(latitude, longitude float64) string {
+	hash := encodeWithin(latitude, longitude, -90, 180)
+
+	var sb strings.Builder
+	sb.Grow(base32Length)
+	for i := 0; i < base32Length; i++ {
+		index := 0
+		if i < base32Length-1 {
+			index = int(hash>>(2*MaxStep-5*(i+1))) & 0x1f
+		}
+		sb.WriteByte(base32Alphabet[index])
+	}
+	return sb.String()
+}
+
+// DecodeBase32 returns the center of the cell named by a standard geohash string of any length
+func DecodeBase32(hash string) (latitude, longitude float64, err error) {
+	minLat, maxLat := -90.0, 90.0
+	minLon, maxLon := MinLongitude, MaxLongitude
+
+	// Bits alternate between longitude and latitude, starting with longitude
+	even := true
+	for _, c := range strings.ToLower(hash) {
+		index := strings.IndexRune(base32Alphabet, c)
+		if index < 0 {
+			return 0, 0, ErrInvalidBase32
+		}
+		for bit := 4; bit >= 0; bit-- {
+			set := index&(1<<bit) != 0
+			if even {
+				mid := (minLon + maxLon) / 2
+				if set {
+					minLon = mid
+				} else {
+					maxLon = mid
+				}
+			} else {
+				mid := (minLat + maxLat) / 2
+				if set {
+					minLat = mid
+				} else {
+					maxLat = mid
+				}
+			}
+			even = !even
+		}
+	}
+
+	return (minLat + maxLat) / 2, (minLon + maxLon) / 2, nil
+}
@@ -0,0 +1,187 @@
+package geohash
+
+import (
+	"math"
+	"sort"
+)
+
+const (
+	// MaxStep is the number of bits per coordinate in the hashes produced by Encode
+	MaxStep = 26
+
+	// mercatorMax is half the length of the equator in meters
+	mercatorMax = 20037726.37
+
+	// coverMargin pads search areas, in degrees, so rounding never drops a point on the edge
+	coverMargin = 1e-9
+)
+
+// Cell is a hash prefix of Step bits per coordinate, covering a rectangle of the map
+// Every hash produced by Encode for a point inside the rectangle starts with Bits.
+type Cell struct {
+	Bits uint64
+	Step int
+}
+
+// Area is a rectangle of the map in degrees
+// Longitudes are not wrapped, so an area crossing the antimeridian has MinLon < -180 or MaxLon > 180.
+type Area struct {
+	MinLat, MaxLat float64
+	MinLon, MaxLon float64
+}
+
+// CellAt returns the cell of the given step holding the point
+func CellAt(latitude, longitude float64, step int) Cell {
+	return Cell{Bits: Encode(latitude, longitude) >> (2 * (MaxStep - step)), Step: step}
+}
+
+// ScoreRange returns the hashes inside the cell, from min inclusive to max exclusive
+func (c Cell) ScoreRange() (uint64, uint64) {
+	shift := 2 * (MaxStep - c.Step)
+	return c.Bits << shift, (c.Bits + 1) << shift
+}
+
+// Bounds returns the rectangle covered by the cell
+func (c Cell) Bounds() Area {
+	latInt, lonInt := deinterleave(c.Bits)
+	cells := math.Pow(2, float64(c.Step))
+	return Area{
+		MinLat: float64(latInt)*latitudeRange/cells + MinLatitude,
+		MaxLat: float64(latInt+1)*latitudeRange/cells + MinLatitude,
+		MinLon: float64(lonInt)*longitudeRange/cells + MinLongitude,
+		MaxLon: float64(lonInt+1)*longitudeRange/cells + MinLongitude,
+	}
+}
+
+// Neighbors returns the cells around c, wrapping around the antimeridian
+// Cells of the first and last row have no neighbors beyond the latitude limits, and at
+// step 1 the east and west neighbors are the same cell, so fewer than eight may be returned.
+func (c Cell) Neighbors() []Cell {
+	latInt, lonInt := deinterleave(c.Bits)
+	size := int64(1) << c.Step
+
+	neighbors := make([]Cell, 0, 8)
+	seen := map[uint64]bool{c.Bits: true}
+	for dLat := int64(-1); dLat <= 1; dLat++ {
+		lat := int64(latInt) + dLat
+		if lat < 0 || lat >= size {
+			continue
+		}
+		for dLon := int64(-1); dLon <= 1; dLon++ {
+			lon := (int64(lonInt) + dLon + size) % size
+			bits := interleave(uint32(lat), uint32(lon))
+			if seen[bits] {
+				continue
+			}
+			seen[bits] = true
+			neighbors = append(neighbors, Cell{Bits: bits, Step: c.Step})
+		}
+	}
+	return neighbors
+}
+
+// covers reports whether the block of c and its neighbors contains area
+func (c Cell) covers(area Area) bool {
+	bounds := c.Bounds()
+	height := bounds.MaxLat - bounds.MinLat
+	width := bounds.MaxLon - bounds.MinLon
+
+	// Nothing is stored beyond the latitude limits, so the area only needs covering up to them
+	if math.Max(area.MinLat, MinLatitude) < bounds.MinLat-height {
+		return false
+	}
+	if math.Min(area.MaxLat, MaxLatitude) > bounds.MaxLat+height {
+		return false
+	}
+	return area.MinLon >= bounds.MinLon-width && area.MaxLon <= bounds.MaxLon+width
+}
+
+// EstimateStep returns the finest step whose cells are still wide enough that a
+// circle of radiusMeters around a point at latitude fits in the point's cell and its neighbors
+// Cells narrow towards the poles, so higher latitudes get a coarser step.
+func EstimateStep(radiusMeters, latitude float64) int {
+	if radiusMeters == 0 {
+		return MaxStep
+	}
+
+	step := 1
+	for r := radiusMeters; r < mercatorMax; r *= 2 {
+		step++
+	}
+	step -= 2
+
+	if latitude > 66 || latitude < -66 {
+		step--
+		if latitude > 80 || latitude < -80 {
+			step--
+		}
+	}
+
+	return max(1, min(step, MaxStep))
+}
+
+// RadiusArea returns the smallest area holding every point within radiusMeters of the center
+func RadiusArea(latitude, longitude, radiusMeters float64) Area {
+	angle := radiusMeters / EarthRadiusMeters
+	latDelta := degrees(angle)
+
+	// The widest parallel of the circle spans asin(sin(angle) / cos(latitude)) either side,
+	// and a circle reaching over a pole spans every longitude
+	lonDelta := 180.0
+	if s := math.Sin(angle) / math.Cos(radians(latitude)); angle < math.Pi/2 && s < 1 {
+		lonDelta = degrees(math.Asin(s))
+	}
+
+	return padArea(latitude, longitude, latDelta, lonDelta)
+}
+
+// BoxArea returns the smallest area holding every point of a widthMeters by heightMeters box
+// centered on the point, with the width measured along each point's own parallel
+func BoxArea(latitude, longitude, widthMeters, heightMeters float64) Area {
+	latDelta := degrees(heightMeters / 2 / EarthRadiusMeters)
+
+	// Parallels shrink away from the equator, so the box is widest in degrees at its poleward edge
+	widest := math.Max(math.Abs(latitude-latDelta), math.Abs(latitude+latDelta))
+	lonDelta := 180.0
+	if s := math.Sin(widthMeters/4/EarthRadiusMeters) / math.Cos(radians(widest)); widest < 90 && s < 1 {
+		lonDelta = degrees(2 * math.Asin(s))
+	}
+
+	return padArea(latitude, longitude, latDelta, lonDelta)
+}
+
+// SearchCells returns the cells that can hold points of area, which surrounds a center point
+// whose farthest point is radiusMeters away
+// The cells are the center's cell and its neighbors at the finest step whose block covers
+// area, sorted so their score ranges ascend.
+func SearchCells(latitude, longitude float64, area Area, radiusMeters float64) []Cell {
+	step := EstimateStep(radiusMeters, latitude)
+	center := CellAt(latitude, longitude, step)
+	for step > 1 && !center.covers(area) {
+		step--
+		center = CellAt(latitude, longitude, step)
+	}
+
+	cells := append([]Cell{center}, center.Neighbors()...)
+	sort.Slice(cells, func(i, j int) bool {
+		return cells[i].Bits < cells[j].Bits
+	})
+	return cells
+}
+
+func padArea(latitude, longitude, latDelta, lonDelta float64) Area {
+	return Area{
+		MinLat: latitude - latDelta - coverMargin,
+		MaxLat: latitude + latDelta + coverMargin,
+		MinLon: longitude - lonDelta - coverMargin,
+		MaxLon: longitude + lonDelta + coverMargin,
+	}
+}
+
+func radians(d float64) float64 {
+	return d * math.Pi / 180
+}
+
+func degrees(r float64) float64 {
+	return r * 180 / math.Pi
+}
@@ -34,8 +34,14 @@ func interleave(x, y uint32) uint64 {
 
 // Encode converts latitude and longitude to a 52-bit geohash score
 func Encode(latitude, longitude float64) uint64 {
+	return encodeWithin(latitude, longitude, MinLatitude, latitudeRange)
+}
+
+// encodeWithin interleaves latitude and longitude normalized to 26 bits each,
+// with latitudes spanning latRange degrees from minLat
+func encodeWithin(latitude, longitude, minLat, latRange float64) uint64 {
 	// Normalize to the range 0-2^26
-	normalizedLatitude := math.Pow(2, 26) * (latitude - MinLatitude) / latitudeRange
+	normalizedLatitude := math.Pow(2, 26) * (latitude - minLat) / latRange
 	normalizedLongitude := math.Pow(2, 26) * (longitude - MinLongitude) / longitudeRange
 
 	// Truncate to integers
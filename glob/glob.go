@@ -0,0 +1,106 @@
+package glob
+
+// Match reports whether s matches the Redis-style glob pattern.
+//
+// Supported syntax:
+//   - '*' matches any sequence of characters (including none)
+//   - '?' matches exactly one character
+//   - '[abc]', '[^abc]' and '[a-z]' match character classes
+//   - '\' escapes the next character
+//
+// Only the last '*' is backtracked to, so matching takes O(len(pattern)*len(s)) steps.
+func Match(pattern, s string) bool {
+	// Resume point after a mismatch: the pattern following the last '*' and the
+	// subject from where that star stopped consuming
+	backtrack := false
+	var starPattern, starS string
+
+	for len(pattern) > 0 || len(s) > 0 {
+		if len(pattern) > 0 {
+			if pattern[0] == '*' {
+				// Consecutive stars collapse as each one is skipped here
+				pattern = pattern[1:]
+				if len(pattern) == 0 {
+					return true
+				}
+				starPattern, starS, backtrack = pattern, s, true
+				continue
+			}
+			if len(s) > 0 {
+				if matched, rest := matchOne(pattern, s[0]); matched {
+					pattern, s = rest, s[1:]
+					continue
+				}
+			}
+		}
+
+		// Mismatch: let the last star consume one more character and retry
+		if !backtrack || len(starS) == 0 {
+			return false
+		}
+		starS = starS[1:]
+		pattern, s = starPattern, starS
+	}
+	return true
+}
+
+// matchOne matches c against the single character token ('?', a class, an escaped or
+// literal character) at the start of pattern and returns the pattern after the token
+func matchOne(pattern string, c byte) (bool, string) {
+	switch pattern[0] {
+	case '?':
+		return true, pattern[1:]
+	case '[':
+		return matchClass(pattern[1:], c)
+	case '\\':
+		if len(pattern) >= 2 {
+			pattern = pattern[1:]
+		}
+	}
+	return pattern[0] == c, pattern[1:]
+}
+
+// matchClass matches c against the character class starting right after '['
+// and returns the remaining pattern after the closing ']'
+func matchClass(pattern string, c byte) (bool, string) {
+	negate := false
+	if len(pattern) > 0 && pattern[0] == '^' {
+		negate = true
+		pattern = pattern[1:]
+	}
+
+	matched := false
+	for len(pattern) > 0 && pattern[0] != ']' {
+		switch {
+		case pattern[0] == '\\' && len(pattern) >= 2:
+			if pattern[1] == c {
+				matched = true
+			}
+			pattern = pattern[2:]
+		case len(pattern) >= 3 && pattern[1] == '-' && pattern[2] != ']':
+			lo, hi := pattern[0], pattern[2]
+			if lo > hi {
+				lo, hi = hi, lo
+			}
+			if c >= lo && c <= hi {
+				matched = true
+			}
+			pattern = pattern[3:]
+		default:
+			if pattern[0] == c {
+				matched = true
+			}
+			pattern = pattern[1:]
+		}
+	}
+
+	// Skip the closing bracket (an unterminated class consumes the rest)
+	if len(pattern) > 0 {
+		pattern = pattern[1:]
+	}
+
+	if negate {
+		matched = !matched
+	}
+	return matched, pattern
+}
@@ -3,6 +3,7 @@ package pubsub
 import (
 	"sync"
 
+	"github.com/SuchintK/GoDisKV/glob"
 	"github.com/SuchintK/GoDisKV/resp"
 	"github.com/SuchintK/GoDisKV/resp/client"
 )
@@ -11,10 +12,16 @@ import (
 type Manager struct {
 	mu       sync.RWMutex
 	channels map[string]map[*client.Client]bool // channel -> set of clients
+	patterns map[string]map[*client.Client]bool // glob pattern -> set of clients
 }
 
-var Global = &Manager{
-	channels: make(map[string]map[*client.Client]bool),
+var Global = newManager()
+
+func newManager() *Manager {
+	return &Manager{
+		channels: make(map[string]map[*client.Client]bool),
+		patterns: make(map[string]map[*client.Client]bool),
+	}
 }
 
 // Subscribe adds a client to a single channel
@@ -22,87 +29,107 @@ func (m *Manager) Subscribe(cli *client.Client, channel string) int {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	if m.channels[channel] == nil {
-		m.channels[channel] = make(map[*client.Client]bool)
-	}
-	m.channels[channel][cli] = true
+	addClient(m.channels, channel, cli)
 	cli.Subscribe(channel)
 	return cli.SubscriptionCount()
 }
 
 // Unsubscribe removes a client from a single channel
-// If channel is empty string, unsubscribe from all channels
 func (m *Manager) Unsubscribe(cli *client.Client, channel string) int {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	// If no channel specified, unsubscribe from all
-	if channel == "" {
-		channels := cli.GetSubscribedChannels()
-		for _, ch := range channels {
-			if m.channels[ch] != nil {
-				delete(m.channels[ch], cli)
-				if len(m.channels[ch]) == 0 {
-					delete(m.channels, ch)
-				}
-			}
-			cli.Unsubscribe(ch)
-		}
-	} else {
-		// Unsubscribe from specific channel
-		if m.channels[channel] != nil {
-			delete(m.channels[channel], cli)
-			if len(m.channels[channel]) == 0 {
-				delete(m.channels, channel)
-			}
-		}
-		cli.Unsubscribe(channel)
-	}
+	removeClient(m.channels, channel, cli)
+	cli.Unsubscribe(channel)
+	return cli.SubscriptionCount()
+}
+
+// PSubscribe adds a client to a single glob pattern
+func (m *Manager) PSubscribe(cli *client.Client, pattern string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	addClient(m.patterns, pattern, cli)
+	cli.PSubscribe(pattern)
+	return cli.SubscriptionCount()
+}
 
+// PUnsubscribe removes a client from a single glob pattern
+func (m *Manager) PUnsubscribe(cli *client.Client, pattern string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	removeClient(m.patterns, pattern, cli)
+	cli.PUnsubscribe(pattern)
 	return cli.SubscriptionCount()
 }
 
-// Publish sends a message to all clients subscribed to the channel
-// Returns the number of clients that received the message
+// Publish sends a message to all clients subscribed to the channel and to
+// every client subscribed to a pattern matching it
+// Returns the number of deliveries, so a client matching both a channel and a
+// pattern (or several patterns) is counted once per subscription
 func (m *Manager) Publish(channel, message string) int {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
-	subscribers := m.channels[channel]
-	if subscribers == nil {
-		return 0
-	}
-
 	count := 0
-	for cli := range subscribers {
-		// Send the message in RESP format
+	if subscribers := m.channels[channel]; subscribers != nil {
 		response := EncodePubSubMessage(channel, message)
-		cli.Write(response)
-		cli.Flush()
-		count++
+		for cli := range subscribers {
+			cli.Write(response)
+			cli.Flush()
+			count++
+		}
+	}
+
+	for pattern, subscribers := range m.patterns {
+		if !glob.Match(pattern, channel) {
+			continue
+		}
+		response := EncodePubSubPatternMessage(pattern, channel, message)
+		for cli := range subscribers {
+			cli.Write(response)
+			cli.Flush()
+			count++
+		}
 	}
 
 	return count
 }
 
-// UnsubscribeAll removes a client from all channels (used on disconnect)
+// UnsubscribeAll removes a client from all channels and patterns (used on disconnect)
 func (m *Manager) UnsubscribeAll(cli *client.Client) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
 	for channel := range m.channels {
-		delete(m.channels[channel], cli)
-		if len(m.channels[channel]) == 0 {
-			delete(m.channels, channel)
-		}
+		removeClient(m.channels, channel, cli)
+	}
+	for pattern := range m.patterns {
+		removeClient(m.patterns, pattern, cli)
 	}
 	cli.ClearSubscriptions()
 }
 
 // ResetGlobal resets the global pub/sub manager (for testing)
 func ResetGlobal() {
-	Global = &Manager{
-		channels: make(map[string]map[*client.Client]bool),
+	Global = newManager()
+}
+
+func addClient(subs map[string]map[*client.Client]bool, name string, cli *client.Client) {
+	if subs[name] == nil {
+		subs[name] = make(map[*client.Client]bool)
+	}
+	subs[name][cli] = true
+}
+
+func removeClient(subs map[string]map[*client.Client]bool, name string, cli *client.Client) {
+	if subs[name] == nil {
+		return
+	}
+	delete(subs[name], cli)
+	if len(subs[name]) == 0 {
+		delete(subs, name)
 	}
 }
 
@@ -115,3 +142,14 @@ func EncodePubSubMessage(channel, message string) []byte {
 		resp.EncodeBulkString(message),
 	})
 }
+
+// EncodePubSubPatternMessage creates a RESP array for a message delivered through a pattern subscription
+// Format: *4\r\n$8\r\npmessage\r\n$<len>\r\n<pattern>\r\n$<len>\r\n<channel>\r\n$<len>\r\n<message>\r\n
+func EncodePubSubPatternMessage(pattern, channel, message string) []byte {
+	return resp.EncodeArray([][]byte{
+		resp.EncodeBulkString("pmessage"),
+		resp.EncodeBulkString(pattern),
+		resp.EncodeBulkString(channel),
+		resp.EncodeBulkString(message),
+	})
+}
@@ -15,6 +15,9 @@ type Client struct {
 	QueuedCommands []QueuedCommand
 	// Pub/Sub state
 	subscribedChannels map[string]bool
+	subscribedPatterns map[string]bool
+	// Replication state
+	propagated [][]string
 }
 
 type QueuedCommand struct {
@@ -31,6 +34,7 @@ func New(conn net.Conn) Client {
 		InTransaction:      false,
 		QueuedCommands:     make([]QueuedCommand, 0),
 		subscribedChannels: make(map[string]bool),
+		subscribedPatterns: make(map[string]bool),
 	}
 }
 
@@ -77,12 +81,21 @@ func (c *Client) Unsubscribe(channel string) {
 	delete(c.subscribedChannels, channel)
 }
 
+func (c *Client) PSubscribe(pattern string) {
+	c.subscribedPatterns[pattern] = true
+}
+
+func (c *Client) PUnsubscribe(pattern string) {
+	delete(c.subscribedPatterns, pattern)
+}
+
 func (c *Client) IsSubscribed() bool {
-	return len(c.subscribedChannels) > 0
+	return c.SubscriptionCount() > 0
 }
 
+// SubscriptionCount returns the number of channels and patterns the client is subscribed to
 func (c *Client) SubscriptionCount() int {
-	return len(c.subscribedChannels)
+	return len(c.subscribedChannels) + len(c.subscribedPatterns)
 }
 
 func (c *Client) GetSubscribedChannels() []string {
@@ -93,6 +106,30 @@ func (c *Client) GetSubscribedChannels() []string {
 	return channels
 }
 
+func (c *Client) GetSubscribedPatterns() []string {
+	patterns := make([]string, 0, len(c.subscribedPatterns))
+	for p := range c.subscribedPatterns {
+		patterns = append(patterns, p)
+	}
+	return patterns
+}
+
 func (c *Client) ClearSubscriptions() {
 	c.subscribedChannels = make(map[string]bool)
+	c.subscribedPatterns = make(map[string]bool)
+}
+
+// Replication methods
+
+// Propagate records a command to be sent to replicas instead of the one being executed
+// Used by commands whose effect is not deterministic (random or blocking ones)
+func (c *Client) Propagate(args ...string) {
+	c.propagated = append(c.propagated, args)
+}
+
+// TakePropagated returns the commands recorded by Propagate and clears them
+func (c *Client) TakePropagated() [][]string {
+	propagated := c.propagated
+	c.propagated = nil
+	return propagated
 }
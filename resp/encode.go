@@ -25,6 +25,10 @@ func EncodeNullBulkString() []byte {
 	return []byte("$-1\r\n")
 }
 
+func EncodeNullArray() []byte {
+	return []byte("*-1\r\n")
+}
+
 func EncodeArrayBulk(values ...string) []byte {
 	respArray := fmt.Sprintf("*%d\r\n", len(values))
 	var sb strings.Builder
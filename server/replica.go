@@ -151,6 +151,8 @@ func handleMaster(c client.Client) {
 		}
 		cmd := command.New(decoded.Label, decoded.Args)
 		response := cmd.Execute(&c)
+		// Replicas have no replicas of their own to forward rewritten commands to
+		c.TakePropagated()
 		if decoded.Label == "replconf" {
 			c.Write(response)
 			c.Flush()
@@ -5,17 +5,22 @@ import (
 	"io"
 	"log"
 	"net"
+	"sync"
 
 	command "github.com/SuchintK/GoDisKV/commands"
 	"github.com/SuchintK/GoDisKV/resp"
 	"github.com/SuchintK/GoDisKV/resp/client"
 	"github.com/SuchintK/GoDisKV/resp/parser"
+	"github.com/SuchintK/GoDisKV/store"
 )
 
 type Server struct {
 	hostname string
 	port     int
 	replicas []client.Client
+	// replicasMu serializes writes to replicas: client goroutines and the active
+	// expiration cycle propagate concurrently
+	replicasMu sync.Mutex
 }
 
 func New(hostname string, port int) *Server {
@@ -35,6 +40,10 @@ func (s *Server) Listen() {
 	defer listener.Close()
 
 	log.Printf("Server running at %s\n", address)
+	// Fields reclaimed in the background are deleted on replicas as well
+	store.StartActiveExpiration(func(key string, fields []string) {
+		s.propagate(append([]string{"hdel", key}, fields...))
+	})
 	for {
 		conn, err := listener.Accept()
 		if err != nil {
@@ -93,23 +102,30 @@ func (s *Server) handleClient(cli *client.Client) {
 		if decoded.Label == "psync" {
 			s.subscribe(cli)
 		}
-		//TODO check if the command is mutable only "set" for now...
-		if decoded.Label == "set" || decoded.Label == "incr" {
-			s.propagate(decoded)
+		// Commands with non-deterministic effects record their own replication stream
+		if propagated := cli.TakePropagated(); len(propagated) > 0 {
+			for _, args := range propagated {
+				s.propagate(args)
+			}
+		} else if command.IsWriteCommand(decoded.Label) {
+			s.propagate(append([]string{decoded.Label}, decoded.Args...))
 		}
 	}
 }
 
 // Subscribe a replica to receive commands from master
 func (s *Server) subscribe(c *client.Client) {
+	s.replicasMu.Lock()
+	defer s.replicasMu.Unlock()
 	s.replicas = append(s.replicas, *c)
 }
 
-// Send cmd to all connected replicas
-func (s *Server) propagate(cmd *parser.Command) {
+// Send cmd (label followed by its arguments) to all connected replicas
+func (s *Server) propagate(cmd []string) {
+	s.replicasMu.Lock()
+	defer s.replicasMu.Unlock()
 	for _, replica := range s.replicas {
-		c := append([]string{cmd.Label}, cmd.Args...)
-		replica.Write(resp.EncodeArrayBulk(c...))
+		replica.Write(resp.EncodeArrayBulk(cmd...))
 		replica.Flush()
 	}
 }
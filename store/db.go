@@ -7,24 +7,117 @@ import (
 func Set(key string, value *Value) {
 	mut.Lock()
 	defer mut.Unlock()
-	db[key] = value
+	set(key, value)
 }
 
 func Get(key string) (*Value, bool) {
 	mut.Lock()
 	defer mut.Unlock()
+	return get(key)
+}
+func Delete(key string) {
+	mut.Lock()
+	defer mut.Unlock()
+	del(key)
+}
+
+// Tx gives access to the keyspace while the database lock is held by Atomically
+type Tx struct{}
+
+// Atomically runs fn with exclusive access to the keyspace, so read-modify-write
+// sequences spanning one or more keys are not interleaved with other clients.
+// fn must use the Tx accessors: calling Get, Set or Delete inside it would deadlock.
+func Atomically(fn func(tx Tx)) {
+	mut.Lock()
+	defer mut.Unlock()
+	fn(Tx{})
+}
+
+func (Tx) Get(key string) (*Value, bool) {
+	return get(key)
+}
+
+func (Tx) Set(key string, value *Value) {
+	set(key, value)
+}
+
+func (Tx) Delete(key string) {
+	del(key)
+}
+
+// removeHooks are the functions registered with OnRemove
+var removeHooks []func(key string)
+
+// OnRemove registers fn to be called whenever a key is deleted, expires or is overwritten
+// by a value of another type, so state attached to the key elsewhere can be dropped.
+// fn runs with the database lock held and must not access the store.
+func OnRemove(fn func(key string)) {
+	mut.Lock()
+	defer mut.Unlock()
+	removeHooks = append(removeHooks, fn)
+}
+
+func notifyRemoved(key string) {
+	for _, fn := range removeHooks {
+		fn(key)
+	}
+}
+
+func set(key string, value *Value) {
+	if old, exists := db[key]; exists && valueType(old) != valueType(value) {
+		notifyRemoved(key)
+	}
+	db[key] = value
+	if value.HashData != nil && value.HashData.hasExpiringFields() {
+		volatileHashes[key] = true
+	}
+}
+
+func get(key string) (*Value, bool) {
 	value, exist := db[key]
 	if exist {
 		if value.ExpiresAt != nil && value.ExpiresAt.Before(time.Now()) {
 			delete(db, key)
+			notifyRemoved(key)
 			return &Value{}, false
 		}
+		// Lazily drop expired hash fields, removing the key with its last field
+		if value.HashData != nil && value.HashData.hasExpiringFields() {
+			value.HashData.removeExpired(time.Now())
+			if value.HashData.Len() == 0 {
+				delete(db, key)
+				delete(volatileHashes, key)
+				notifyRemoved(key)
+				return &Value{}, false
+			}
+		}
 		return value, exist
 	}
 	return &Value{}, false
 }
-func Delete(key string) {
-	mut.Lock()
-	defer mut.Unlock()
+
+func del(key string) {
+	if _, exists := db[key]; exists {
+		notifyRemoved(key)
+	}
 	delete(db, key)
+	delete(volatileHashes, key)
+}
+
+// valueType names the kind of data held by value
+func valueType(value *Value) string {
+	switch {
+	case value.StreamData != nil:
+		return "stream"
+	case value.SortedSetData != nil:
+		return "zset"
+	case value.HashData != nil:
+		return "hash"
+	case value.SetData != nil:
+		return "set"
+	case value.ListData != nil:
+		return "list"
+	default:
+		return "string"
+	}
 }
@@ -0,0 +1,57 @@
+package store
+
+import (
+	"sync"
+	"time"
+)
+
+// activeExpireInterval is how often expired hash fields are reclaimed in the background
+const activeExpireInterval = 100 * time.Millisecond
+
+// volatileHashes tracks the keys of hashes that have at least one field with a TTL
+var volatileHashes = make(map[string]bool)
+
+var startExpiration sync.Once
+
+// StartActiveExpiration launches the background cycle that removes expired hash fields
+// which are never read again. Lazy expiration in Get covers fields that are accessed.
+// onExpired is called with the fields removed from each hash, so the deletions can be
+// sent to replicas; it runs with the database lock held and must not access the store.
+func StartActiveExpiration(onExpired func(key string, fields []string)) {
+	startExpiration.Do(func() {
+		go func() {
+			ticker := time.NewTicker(activeExpireInterval)
+			defer ticker.Stop()
+			for now := range ticker.C {
+				activeExpireCycle(now, onExpired)
+			}
+		}()
+	})
+}
+
+// activeExpireCycle removes expired fields from every tracked hash
+func activeExpireCycle(now time.Time, onExpired func(key string, fields []string)) {
+	mut.Lock()
+	defer mut.Unlock()
+
+	for key := range volatileHashes {
+		value, exists := db[key]
+		if !exists || value.HashData == nil {
+			delete(volatileHashes, key)
+			continue
+		}
+
+		if expired := value.HashData.removeExpired(now); len(expired) > 0 && onExpired != nil {
+			onExpired(key, expired)
+		}
+		if value.HashData.Len() == 0 {
+			delete(db, key)
+			delete(volatileHashes, key)
+			notifyRemoved(key)
+			continue
+		}
+		if !value.HashData.hasExpiringFields() {
+			delete(volatileHashes, key)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package store
+
+import (
+	"sort"
+	"time"
+)
+
+// Hash represents a Redis hash (field -> value map)
+type Hash struct {
+	fields  map[string]string
+	expires map[string]time.Time // field -> expiration time, only for fields with a TTL
+	order   *ScanOrder           // built by the first HSCAN
+}
+
+// NewHash creates a new empty hash
+func NewHash() *Hash {
+	return &Hash{
+		fields:  make(map[string]string),
+		expires: make(map[string]time.Time),
+	}
+}
+
+// Set sets field to value, clearing any TTL the field had
+// Returns true if the field is new, false if an existing value was overwritten
+func (h *Hash) Set(field, value string) bool {
+	_, exists := h.fields[field]
+	h.fields[field] = value
+	delete(h.expires, field)
+	h.order.add(field)
+	return !exists
+}
+
+// Update sets field to value, keeping any TTL the field has
+// Returns true if the field is new, false if an existing value was overwritten
+func (h *Hash) Update(field, value string) bool {
+	_, exists := h.fields[field]
+	h.fields[field] = value
+	h.order.add(field)
+	return !exists
+}
+
+// Get returns the value stored at field
+func (h *Hash) Get(field string) (string, bool) {
+	value, exists := h.fields[field]
+	return value, exists
+}
+
+// Exists reports whether field is present in the hash
+func (h *Hash) Exists(field string) bool {
+	_, exists := h.fields[field]
+	return exists
+}
+
+// Delete removes field from the hash
+// Returns true if the field was removed
+func (h *Hash) Delete(field string) bool {
+	if _, exists := h.fields[field]; !exists {
+		return false
+	}
+	delete(h.fields, field)
+	delete(h.expires, field)
+	h.order.remove(field)
+	return true
+}
+
+// Len returns the number of fields in the hash
+func (h *Hash) Len() int {
+	return len(h.fields)
+}
+
+// Fields returns all field names in lexicographic order
+func (h *Hash) Fields() []string {
+	fields := make([]string, 0, len(h.fields))
+	for field := range h.fields {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// ScanOrder returns the order in which HSCAN visits the fields
+// It starts in lexicographic order and is kept up to date as fields are added and removed.
+func (h *Hash) ScanOrder() *ScanOrder {
+	if h.order == nil {
+		h.order = newScanOrder(h.Fields())
+	}
+	return h.order
+}
+
+// Expire sets the expiration time of an existing field
+// Returns false if the field does not exist
+func (h *Hash) Expire(field string, at time.Time) bool {
+	if _, exists := h.fields[field]; !exists {
+		return false
+	}
+	h.expires[field] = at
+	return true
+}
+
+// ExpiresAt returns the expiration time of field, if it has one
+func (h *Hash) ExpiresAt(field string) (time.Time, bool) {
+	at, exists := h.expires[field]
+	return at, exists
+}
+
+// Persist removes the TTL of field
+// Returns true if the field had a TTL
+func (h *Hash) Persist(field string) bool {
+	if _, exists := h.expires[field]; !exists {
+		return false
+	}
+	delete(h.expires, field)
+	return true
+}
+
+// hasExpiringFields reports whether any field has a TTL
+func (h *Hash) hasExpiringFields() bool {
+	return len(h.expires) > 0
+}
+
+// removeExpired deletes every field whose TTL has elapsed at now
+// Returns the removed fields in lexicographic order
+func (h *Hash) removeExpired(now time.Time) []string {
+	var expired []string
+	for field, at := range h.expires {
+		if !at.After(now) {
+			delete(h.fields, field)
+			delete(h.expires, field)
+			h.order.remove(field)
+			expired = append(expired, field)
+		}
+	}
+	sort.Strings(expired)
+	return expired
+}
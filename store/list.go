@@ -0,0 +1,286 @@
+package store
+
+// listNodeSize is the maximum number of elements packed into a single list node
+const listNodeSize = 128
+
+// List represents a Redis list
+//
+// Elements are stored in a doubly linked list of nodes, each packing up to listNodeSize
+// elements in a contiguous slice (the quicklist layout). Pushing or popping at either end
+// only touches the head or tail node, and index lookups skip whole nodes, walking from
+// whichever end is closer.
+type List struct {
+	head   *listNode
+	tail   *listNode
+	length int
+}
+
+type listNode struct {
+	prev    *listNode
+	next    *listNode
+	entries []string
+}
+
+// NewList creates a list holding elements, in order from head to tail
+func NewList(elements ...string) *List {
+	l := &List{}
+	for _, element := range elements {
+		l.PushRight(element)
+	}
+	return l
+}
+
+// Len returns the number of elements in the list
+func (l *List) Len() int {
+	return l.length
+}
+
+// PushLeft inserts element at the head of the list
+func (l *List) PushLeft(element string) {
+	if l.head == nil || len(l.head.entries) == listNodeSize {
+		l.linkBefore(l.head, &listNode{entries: make([]string, 0, listNodeSize)})
+	}
+	node := l.head
+	node.entries = append(node.entries, "")
+	copy(node.entries[1:], node.entries)
+	node.entries[0] = element
+	l.length++
+}
+
+// PushRight inserts element at the tail of the list
+func (l *List) PushRight(element string) {
+	if l.tail == nil || len(l.tail.entries) == listNodeSize {
+		l.linkAfter(l.tail, &listNode{entries: make([]string, 0, listNodeSize)})
+	}
+	l.tail.entries = append(l.tail.entries, element)
+	l.length++
+}
+
+// PopLeft removes and returns the element at the head of the list
+// Returns false if the list is empty
+func (l *List) PopLeft() (string, bool) {
+	if l.length == 0 {
+		return "", false
+	}
+	element := l.head.entries[0]
+	l.removeLeft(1)
+	return element, true
+}
+
+// PopRight removes and returns the element at the tail of the list
+// Returns false if the list is empty
+func (l *List) PopRight() (string, bool) {
+	if l.length == 0 {
+		return "", false
+	}
+	entries := l.tail.entries
+	element := entries[len(entries)-1]
+	l.removeRight(1)
+	return element, true
+}
+
+// Index returns the element at position index, which must be in [0, Len())
+func (l *List) Index(index int) string {
+	node, offset := l.locate(index)
+	return node.entries[offset]
+}
+
+// Set replaces the element at position index, which must be in [0, Len())
+func (l *List) Set(index int, element string) {
+	node, offset := l.locate(index)
+	node.entries[offset] = element
+}
+
+// Insert inserts element before position index, which must be in [0, Len()]
+// Inserting at Len() appends to the tail
+func (l *List) Insert(index int, element string) {
+	switch index {
+	case 0:
+		l.PushLeft(element)
+		return
+	case l.length:
+		l.PushRight(element)
+		return
+	}
+
+	node, offset := l.locate(index)
+	if len(node.entries) == listNodeSize {
+		// Split the full node in half and insert into whichever half holds the position
+		half := listNodeSize / 2
+		split := &listNode{entries: make([]string, 0, listNodeSize)}
+		split.entries = append(split.entries, node.entries[half:]...)
+		clear(node.entries[half:])
+		node.entries = node.entries[:half]
+		l.linkAfter(node, split)
+		if offset >= half {
+			node, offset = split, offset-half
+		}
+	}
+
+	node.entries = append(node.entries, "")
+	copy(node.entries[offset+1:], node.entries[offset:])
+	node.entries[offset] = element
+	l.length++
+}
+
+// Range returns the elements between start and stop inclusive
+// Both must be in [0, Len()) with start <= stop
+func (l *List) Range(start, stop int) []string {
+	result := make([]string, 0, stop-start+1)
+	node, offset := l.locate(start)
+	for len(result) < cap(result) {
+		take := node.entries[offset:]
+		if remaining := cap(result) - len(result); len(take) > remaining {
+			take = take[:remaining]
+		}
+		result = append(result, take...)
+		node, offset = node.next, 0
+	}
+	return result
+}
+
+// Elements returns every element from head to tail
+func (l *List) Elements() []string {
+	if l.length == 0 {
+		return []string{}
+	}
+	return l.Range(0, l.length-1)
+}
+
+// Trim keeps only the elements between start and stop inclusive
+// Both must be in [0, Len()) with start <= stop
+func (l *List) Trim(start, stop int) {
+	l.removeRight(l.length - 1 - stop)
+	l.removeLeft(start)
+}
+
+// Iterate calls fn with the position and value of each element, from head to tail
+// or from tail to head when reverse is set, until fn returns false
+func (l *List) Iterate(reverse bool, fn func(index int, element string) bool) {
+	if reverse {
+		index := l.length - 1
+		for node := l.tail; node != nil; node = node.prev {
+			for i := len(node.entries) - 1; i >= 0; i-- {
+				if !fn(index, node.entries[i]) {
+					return
+				}
+				index--
+			}
+		}
+		return
+	}
+
+	index := 0
+	for node := l.head; node != nil; node = node.next {
+		for _, element := range node.entries {
+			if !fn(index, element) {
+				return
+			}
+			index++
+		}
+	}
+}
+
+// locate returns the node holding position index and the offset of the element within it
+func (l *List) locate(index int) (*listNode, int) {
+	if index < l.length/2 {
+		node := l.head
+		for index >= len(node.entries) {
+			index -= len(node.entries)
+			node = node.next
+		}
+		return node, index
+	}
+
+	node := l.tail
+	fromTail := l.length - 1 - index
+	for fromTail >= len(node.entries) {
+		fromTail -= len(node.entries)
+		node = node.prev
+	}
+	return node, len(node.entries) - 1 - fromTail
+}
+
+// removeLeft drops the first n elements, unlinking nodes that become empty
+func (l *List) removeLeft(n int) {
+	l.length -= n
+	for n > 0 {
+		node := l.head
+		if n >= len(node.entries) {
+			n -= len(node.entries)
+			l.unlink(node)
+			continue
+		}
+		clear(node.entries[:n])
+		node.entries = node.entries[n:]
+		n = 0
+	}
+}
+
+// removeRight drops the last n elements, unlinking nodes that become empty
+func (l *List) removeRight(n int) {
+	l.length -= n
+	for n > 0 {
+		node := l.tail
+		if n >= len(node.entries) {
+			n -= len(node.entries)
+			l.unlink(node)
+			continue
+		}
+		keep := len(node.entries) - n
+		clear(node.entries[keep:])
+		node.entries = node.entries[:keep]
+		n = 0
+	}
+}
+
+// linkBefore inserts node in front of next, or at the tail when next is nil
+func (l *List) linkBefore(next, node *listNode) {
+	if next == nil {
+		l.linkAfter(l.tail, node)
+		return
+	}
+	node.prev, node.next = next.prev, next
+	if next.prev == nil {
+		l.head = node
+	} else {
+		next.prev.next = node
+	}
+	next.prev = node
+}
+
+// linkAfter inserts node behind prev, or at the head when prev is nil
+func (l *List) linkAfter(prev, node *listNode) {
+	if prev == nil {
+		node.prev, node.next = nil, l.head
+		if l.head == nil {
+			l.tail = node
+		} else {
+			l.head.prev = node
+		}
+		l.head = node
+		return
+	}
+	node.prev, node.next = prev, prev.next
+	if prev.next == nil {
+		l.tail = node
+	} else {
+		prev.next.prev = node
+	}
+	prev.next = node
+}
+
+// unlink removes node from the list
+func (l *List) unlink(node *listNode) {
+	if node.prev == nil {
+		l.head = node.next
+	} else {
+		node.prev.next = node.next
+	}
+	if node.next == nil {
+		l.tail = node.prev
+	} else {
+		node.next.prev = node.prev
+	}
+	node.prev, node.next = nil, nil
+}
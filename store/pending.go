@@ -0,0 +1,139 @@
+package store
+
+import (
+	"slices"
+	"sort"
+)
+
+// pendingNodeSize is how many entries a node of a pending entries list holds at most
+const pendingNodeSize = 128
+
+// PendingList is a pending entries list (PEL): the entries delivered to the consumers of a
+// group and not acknowledged yet
+// Entries are indexed by ID for lookups and kept in ID order in a chunked array, so range
+// scans seek their start by binary search instead of sorting the whole list.
+type PendingList struct {
+	byID  map[StreamID]*PendingEntry
+	nodes []*pendingNode
+}
+
+// pendingNode is a chunk of consecutive pending entries in ID order, never left empty
+type pendingNode struct {
+	entries []*PendingEntry
+}
+
+// NewPendingList creates an empty pending entries list
+func NewPendingList() *PendingList {
+	return &PendingList{byID: make(map[StreamID]*PendingEntry)}
+}
+
+// Len returns the number of pending entries
+func (l *PendingList) Len() int {
+	return len(l.byID)
+}
+
+// Get returns the pending entry with the given ID
+func (l *PendingList) Get(id StreamID) (*PendingEntry, bool) {
+	pending, exists := l.byID[id]
+	return pending, exists
+}
+
+// seek returns the position of the first entry with an ID greater than or equal to id
+// The node index is len(l.nodes) when there is no such entry.
+func (l *PendingList) seek(id StreamID) (int, int) {
+	n := sort.Search(len(l.nodes), func(i int) bool {
+		entries := l.nodes[i].entries
+		return entries[len(entries)-1].ID.Compare(id) >= 0
+	})
+	if n == len(l.nodes) {
+		return n, 0
+	}
+	entries := l.nodes[n].entries
+	e := sort.Search(len(entries), func(j int) bool {
+		return entries[j].ID.Compare(id) >= 0
+	})
+	return n, e
+}
+
+// Add inserts pending, which must not be in the list yet
+// Entries are usually delivered in ID order, so most inserts append to the last node.
+func (l *PendingList) Add(pending *PendingEntry) {
+	l.byID[pending.ID] = pending
+
+	n, e := l.seek(pending.ID)
+	if n == len(l.nodes) {
+		// Greater than every entry: append to the last node, or start a new one
+		if n == 0 || len(l.nodes[n-1].entries) >= pendingNodeSize {
+			l.nodes = append(l.nodes, &pendingNode{entries: make([]*PendingEntry, 0, pendingNodeSize)})
+			n++
+		}
+		last := l.nodes[n-1]
+		last.entries = append(last.entries, pending)
+		return
+	}
+
+	node := l.nodes[n]
+	if len(node.entries) >= pendingNodeSize {
+		// Split the full node in two halves
+		half := len(node.entries) / 2
+		upper := &pendingNode{entries: append(make([]*PendingEntry, 0, pendingNodeSize), node.entries[half:]...)}
+		clear(node.entries[half:])
+		node.entries = node.entries[:half]
+		l.nodes = slices.Insert(l.nodes, n+1, upper)
+		if e > half {
+			node, e = upper, e-half
+		}
+	}
+	node.entries = slices.Insert(node.entries, e, pending)
+}
+
+// Remove deletes the entry with the given ID
+// Returns false if it was not in the list
+func (l *PendingList) Remove(id StreamID) bool {
+	if _, exists := l.byID[id]; !exists {
+		return false
+	}
+	delete(l.byID, id)
+
+	n, e := l.seek(id)
+	node := l.nodes[n]
+	node.entries = slices.Delete(node.entries, e, e+1)
+	if len(node.entries) == 0 {
+		l.nodes = slices.Delete(l.nodes, n, n+1)
+	}
+	return true
+}
+
+// First returns the pending entry with the smallest ID, or nil if the list is empty
+func (l *PendingList) First() *PendingEntry {
+	if len(l.nodes) == 0 {
+		return nil
+	}
+	return l.nodes[0].entries[0]
+}
+
+// Last returns the pending entry with the greatest ID, or nil if the list is empty
+func (l *PendingList) Last() *PendingEntry {
+	if len(l.nodes) == 0 {
+		return nil
+	}
+	last := l.nodes[len(l.nodes)-1].entries
+	return last[len(last)-1]
+}
+
+// Range calls fn with the entries whose ID is greater than or equal to start, in ID order,
+// until fn returns false
+// fn may add or remove entries: the scan seeks past the ID it last visited at every step.
+func (l *PendingList) Range(start StreamID, fn func(pending *PendingEntry) bool) {
+	for n, e := l.seek(start); n < len(l.nodes); n, e = l.seek(start) {
+		pending := l.nodes[n].entries[e]
+		if !fn(pending) {
+			return
+		}
+		next, ok := pending.ID.Next()
+		if !ok {
+			return
+		}
+		start = next
+	}
+}
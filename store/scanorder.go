@@ -0,0 +1,103 @@
+package store
+
+import "sort"
+
+// ScanOrder numbers the elements of a collection in insertion order for SCAN cursors
+// A cursor is the sequence number to resume from, so it stays valid while elements are
+// added or removed: an element present for the whole scan is returned exactly once.
+// The methods that update the order are no-ops on a nil ScanOrder, so collections only
+// maintain one once they have been scanned.
+type ScanOrder struct {
+	seqs    map[string]uint64 // element -> sequence number
+	slots   []scanSlot        // elements in sequence order, including removed ones
+	nextSeq uint64
+	holes   int // number of removed slots
+}
+
+// scanSlot is an element of a ScanOrder, or the hole it left when removed
+type scanSlot struct {
+	seq     uint64
+	element string
+	removed bool
+}
+
+// newScanOrder creates a scan order holding elements in the given order
+func newScanOrder(elements []string) *ScanOrder {
+	o := &ScanOrder{
+		seqs:  make(map[string]uint64, len(elements)),
+		slots: make([]scanSlot, 0, len(elements)),
+	}
+	for _, element := range elements {
+		o.add(element)
+	}
+	return o
+}
+
+// add appends element if it is not in the order yet
+func (o *ScanOrder) add(element string) {
+	if o == nil {
+		return
+	}
+	if _, exists := o.seqs[element]; exists {
+		return
+	}
+	o.seqs[element] = o.nextSeq
+	o.slots = append(o.slots, scanSlot{seq: o.nextSeq, element: element})
+	o.nextSeq++
+}
+
+// remove leaves a hole in place of element
+// The slots are compacted once more than half of them are holes.
+func (o *ScanOrder) remove(element string) {
+	if o == nil {
+		return
+	}
+	seq, exists := o.seqs[element]
+	if !exists {
+		return
+	}
+	delete(o.seqs, element)
+	i := o.search(seq)
+	o.slots[i] = scanSlot{seq: seq, removed: true}
+	o.holes++
+
+	if o.holes > len(o.slots)/2 {
+		live := o.slots[:0]
+		for _, slot := range o.slots {
+			if !slot.removed {
+				live = append(live, slot)
+			}
+		}
+		clear(o.slots[len(live):])
+		o.slots = live
+		o.holes = 0
+	}
+}
+
+// search returns the index of the first slot with a sequence number of at least seq
+func (o *ScanOrder) search(seq uint64) int {
+	return sort.Search(len(o.slots), func(i int) bool {
+		return o.slots[i].seq >= seq
+	})
+}
+
+// Scan returns up to count elements starting at cursor, and the cursor of the next page
+// The next cursor is 0 once the scan is complete.
+func (o *ScanOrder) Scan(cursor uint64, count int) ([]string, uint64) {
+	var elements []string
+	i := o.search(cursor)
+	for ; i < len(o.slots) && len(elements) < count; i++ {
+		if !o.slots[i].removed {
+			elements = append(elements, o.slots[i].element)
+		}
+	}
+
+	// Skip trailing holes so that the last page already reports the end of the scan
+	for i < len(o.slots) && o.slots[i].removed {
+		i++
+	}
+	if i == len(o.slots) {
+		return elements, 0
+	}
+	return elements, o.slots[i].seq
+}
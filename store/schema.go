@@ -12,21 +12,32 @@ var mut sync.Mutex = sync.Mutex{}
 type Value struct {
 	StreamData    *Stream
 	SortedSetData *SortedSet
-	ListData      []string
+	HashData      *Hash
+	SetData       *UnorderedSet
+	ListData      *List
 	Data          string
 	ExpiresAt     *time.Time
 }
 
 // StreamEntry represents a single entry in a stream
 type StreamEntry struct {
-	Id     string
-	Fields map[string]string
+	ID     StreamID
+	Fields []StreamField // in the order they were added, duplicates included
+}
+
+// StreamField is a field/value pair of a stream entry
+type StreamField struct {
+	Name  string
+	Value string
 }
 
 // Stream represents a Redis stream
+// Entries are kept in ID order in a chunked array, see streamindex.go.
 type Stream struct {
-	Entries       []*StreamEntry
-	LastID        string
-	LastTimestamp int64
-	LastSequence  int64
+	nodes        []*streamNode
+	length       int
+	LastID       StreamID // the ID of the newest entry ever added, which new IDs must exceed
+	EntriesAdded int64    // how many entries were ever added
+	MaxDeletedID StreamID // the greatest ID removed by XDEL
+	Groups       map[string]*ConsumerGroup
 }
@@ -0,0 +1,217 @@
+package store
+
+import (
+	"math/rand"
+	"sort"
+	"strconv"
+)
+
+// maxIntsetEntries is the size above which an integer set is converted to a hash table
+const maxIntsetEntries = 512
+
+// UnorderedSet represents a Redis set of unique strings
+//
+// Small sets made only of integers are kept as a sorted []int64 (intset encoding),
+// which is far more compact than a map. The set is converted to a map as soon as
+// a non-integer member is added or it grows beyond maxIntsetEntries.
+type UnorderedSet struct {
+	intset  []int64             // sorted members, used while members == nil
+	members map[string]struct{} // hash table encoding
+	order   *ScanOrder          // built by the first SSCAN
+}
+
+// NewUnorderedSet creates a new empty set using the intset encoding
+func NewUnorderedSet() *UnorderedSet {
+	return &UnorderedSet{
+		intset: []int64{},
+	}
+}
+
+// IsIntset reports whether the set is using the compact integer encoding
+func (s *UnorderedSet) IsIntset() bool {
+	return s.members == nil
+}
+
+// parseSetInteger returns the integer value of member if it is in canonical form
+// ("12" but not "012", "+12" or "-0"), which is required to round-trip through the intset
+func parseSetInteger(member string) (int64, bool) {
+	n, err := strconv.ParseInt(member, 10, 64)
+	if err != nil || strconv.FormatInt(n, 10) != member {
+		return 0, false
+	}
+	return n, true
+}
+
+// intsetSearch returns the position of n in the intset and whether it is present
+func (s *UnorderedSet) intsetSearch(n int64) (int, bool) {
+	i := sort.Search(len(s.intset), func(i int) bool { return s.intset[i] >= n })
+	return i, i < len(s.intset) && s.intset[i] == n
+}
+
+// convertToHashTable switches the set to the map encoding
+func (s *UnorderedSet) convertToHashTable() {
+	s.members = make(map[string]struct{}, len(s.intset))
+	for _, n := range s.intset {
+		s.members[strconv.FormatInt(n, 10)] = struct{}{}
+	}
+	s.intset = nil
+}
+
+// Add adds member to the set
+// Returns true if the member was not already present
+func (s *UnorderedSet) Add(member string) bool {
+	if s.IsIntset() {
+		n, ok := parseSetInteger(member)
+		if ok {
+			i, found := s.intsetSearch(n)
+			if found {
+				return false
+			}
+			if len(s.intset) < maxIntsetEntries {
+				s.intset = append(s.intset, 0)
+				copy(s.intset[i+1:], s.intset[i:])
+				s.intset[i] = n
+				s.order.add(member)
+				return true
+			}
+		}
+		s.convertToHashTable()
+	}
+
+	if _, exists := s.members[member]; exists {
+		return false
+	}
+	s.members[member] = struct{}{}
+	s.order.add(member)
+	return true
+}
+
+// Remove removes member from the set
+// Returns true if the member was present
+func (s *UnorderedSet) Remove(member string) bool {
+	if s.IsIntset() {
+		n, ok := parseSetInteger(member)
+		if !ok {
+			return false
+		}
+		i, found := s.intsetSearch(n)
+		if !found {
+			return false
+		}
+		s.intset = append(s.intset[:i], s.intset[i+1:]...)
+		s.order.remove(member)
+		return true
+	}
+
+	if _, exists := s.members[member]; !exists {
+		return false
+	}
+	delete(s.members, member)
+	s.order.remove(member)
+	return true
+}
+
+// Contains reports whether member is in the set
+func (s *UnorderedSet) Contains(member string) bool {
+	if s.IsIntset() {
+		n, ok := parseSetInteger(member)
+		if !ok {
+			return false
+		}
+		_, found := s.intsetSearch(n)
+		return found
+	}
+	_, exists := s.members[member]
+	return exists
+}
+
+// Card returns the cardinality (number of members)
+func (s *UnorderedSet) Card() int {
+	if s.IsIntset() {
+		return len(s.intset)
+	}
+	return len(s.members)
+}
+
+// Members returns all members, in numeric order for intsets and lexicographic order otherwise
+func (s *UnorderedSet) Members() []string {
+	if s.IsIntset() {
+		result := make([]string, len(s.intset))
+		for i, n := range s.intset {
+			result[i] = strconv.FormatInt(n, 10)
+		}
+		return result
+	}
+
+	result := make([]string, 0, len(s.members))
+	for member := range s.members {
+		result = append(result, member)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// ScanOrder returns the order in which SSCAN visits the members
+// It starts in the order of Members and is kept up to date as members are added and removed.
+func (s *UnorderedSet) ScanOrder() *ScanOrder {
+	if s.order == nil {
+		s.order = newScanOrder(s.Members())
+	}
+	return s.order
+}
+
+// Iterate calls fn with each member, in no particular order, until fn returns false
+func (s *UnorderedSet) Iterate(fn func(member string) bool) {
+	if s.IsIntset() {
+		for _, n := range s.intset {
+			if !fn(strconv.FormatInt(n, 10)) {
+				return
+			}
+		}
+		return
+	}
+
+	for member := range s.members {
+		if !fn(member) {
+			return
+		}
+	}
+}
+
+// RandomDistinct returns count distinct members chosen uniformly, or every member
+// when count is at least the cardinality. The set is walked once (reservoir sampling).
+func (s *UnorderedSet) RandomDistinct(count int) []string {
+	if count <= 0 {
+		return []string{}
+	}
+
+	picked := make([]string, 0, min(count, s.Card()))
+	seen := 0
+	s.Iterate(func(member string) bool {
+		if len(picked) < count {
+			picked = append(picked, member)
+		} else if i := rand.Intn(seen + 1); i < count {
+			picked[i] = member
+		}
+		seen++
+		return true
+	})
+	return picked
+}
+
+// Random returns a uniformly chosen member without removing it
+// The set must not be empty
+func (s *UnorderedSet) Random() string {
+	if s.IsIntset() {
+		return strconv.FormatInt(s.intset[rand.Intn(len(s.intset))], 10)
+	}
+
+	target := rand.Intn(len(s.members))
+	for member := range s.members {
+		if target == 0 {
+			return member
+		}
+		target--
+	}
+	return ""
+}
@@ -11,14 +11,24 @@ const (
 
 // SkipListNode represents a node in the skip list
 type SkipListNode struct {
-	Member string
-	Score  float64
-	Next   []*SkipListNode // Contains the next node
+	Member   string
+	Score    float64
+	Backward *SkipListNode   // previous node at level 0, nil for the first node
+	Level    []SkipListLevel // forward links, one per level the node belongs to
+}
+
+// SkipListLevel is a forward link of a node at one level
+// Span counts the level 0 steps the link jumps over, which is what lets rank
+// lookups add spans while descending instead of walking level 0.
+type SkipListLevel struct {
+	Forward *SkipListNode
+	Span    int
 }
 
 // SkipList represents a skip list for sorted set
 type SkipList struct {
 	header *SkipListNode
+	tail   *SkipListNode
 	level  int
 	length int
 }
@@ -27,7 +37,7 @@ type SkipList struct {
 func NewSkipList() *SkipList {
 	return &SkipList{
 		header: &SkipListNode{
-			Next: make([]*SkipListNode, maxLevel),
+			Level: make([]SkipListLevel, maxLevel),
 		},
 		level:  0,
 		length: 0,
@@ -43,34 +53,43 @@ func (sl *SkipList) randomLevel() int {
 	return level
 }
 
+// nodeBefore reports whether node sorts before (score, member)
+func nodeBefore(node *SkipListNode, score float64, member string) bool {
+	return node.Score < score || (node.Score == score && node.Member < member)
+}
+
 // Insert inserts or updates a member with a score
 func (sl *SkipList) Insert(score float64, member string) *SkipListNode {
 	update := make([]*SkipListNode, maxLevel)
+	rank := make([]int, maxLevel) // rank of update[i], counted in level 0 steps from the header
 	current := sl.header
 
-	// Find the position to insert
+	// Find the position to insert, recording the rank reached at each level
 	for i := sl.level; i >= 0; i-- {
-		for current.Next[i] != nil &&
-			(current.Next[i].Score < score ||
-				(current.Next[i].Score == score && current.Next[i].Member < member)) {
-			current = current.Next[i]
+		if i < sl.level {
+			rank[i] = rank[i+1]
+		}
+		for current.Level[i].Forward != nil && nodeBefore(current.Level[i].Forward, score, member) {
+			rank[i] += current.Level[i].Span
+			current = current.Level[i].Forward
 		}
 		update[i] = current
 	}
 
 	// Check if member already exists at this position
-	current = current.Next[0]
-	if current != nil && current.Score == score && current.Member == member {
-		return current
+	if next := current.Level[0].Forward; next != nil && next.Score == score && next.Member == member {
+		return next
 	}
 
 	// Generate random level for new node
 	newLevel := sl.randomLevel()
 
-	// Levels will be added to the top of the list
+	// Levels will be added to the top of the list, spanning every existing node
 	if newLevel > sl.level {
 		for i := sl.level + 1; i <= newLevel; i++ {
+			rank[i] = 0
 			update[i] = sl.header
+			update[i].Level[i].Span = sl.length
 		}
 		sl.level = newLevel
 	}
@@ -79,13 +98,29 @@ func (sl *SkipList) Insert(score float64, member string) *SkipListNode {
 	newNode := &SkipListNode{
 		Member: member,
 		Score:  score,
-		Next:   make([]*SkipListNode, newLevel+1),
+		Level:  make([]SkipListLevel, newLevel+1),
 	}
 
-	// Insert node
+	// Insert node, splitting the span of each link it is placed under
 	for i := 0; i <= newLevel; i++ {
-		newNode.Next[i] = update[i].Next[i]
-		update[i].Next[i] = newNode
+		newNode.Level[i].Forward = update[i].Level[i].Forward
+		update[i].Level[i].Forward = newNode
+		newNode.Level[i].Span = update[i].Level[i].Span - (rank[0] - rank[i])
+		update[i].Level[i].Span = rank[0] - rank[i] + 1
+	}
+
+	// Links above the new node now jump over one more node
+	for i := newLevel + 1; i <= sl.level; i++ {
+		update[i].Level[i].Span++
+	}
+
+	if update[0] != sl.header {
+		newNode.Backward = update[0]
+	}
+	if newNode.Level[0].Forward != nil {
+		newNode.Level[0].Forward.Backward = newNode
+	} else {
+		sl.tail = newNode
 	}
 
 	sl.length++
@@ -99,29 +134,35 @@ func (sl *SkipList) Delete(score float64, member string) bool {
 
 	// Find the node to delete
 	for i := sl.level; i >= 0; i-- {
-		for current.Next[i] != nil &&
-			(current.Next[i].Score < score ||
-				(current.Next[i].Score == score && current.Next[i].Member < member)) {
-			current = current.Next[i]
+		for current.Level[i].Forward != nil && nodeBefore(current.Level[i].Forward, score, member) {
+			current = current.Level[i].Forward
 		}
 		update[i] = current
 	}
 
-	current = current.Next[0]
+	current = current.Level[0].Forward
 	if current == nil || current.Score != score || current.Member != member {
 		return false
 	}
 
-	// Remove node from all levels
+	// Unlink the node from the levels it belongs to, and shorten the links jumping over it
 	for i := 0; i <= sl.level; i++ {
-		if update[i].Next[i] != current {
-			break
+		if update[i].Level[i].Forward == current {
+			update[i].Level[i].Span += current.Level[i].Span - 1
+			update[i].Level[i].Forward = current.Level[i].Forward
+		} else {
+			update[i].Level[i].Span--
 		}
-		update[i].Next[i] = current.Next[i]
+	}
+
+	if current.Level[0].Forward != nil {
+		current.Level[0].Forward.Backward = current.Backward
+	} else {
+		sl.tail = current.Backward
 	}
 
 	// Update level if needed
-	for sl.level > 0 && sl.header.Next[sl.level] == nil {
+	for sl.level > 0 && sl.header.Level[sl.level].Forward == nil {
 		sl.level--
 	}
 
@@ -130,29 +171,48 @@ func (sl *SkipList) Delete(score float64, member string) bool {
 }
 
 // GetRank returns the rank (0-based) of a member
-// Traverses level 0 directly to count position
+// Adds up the spans crossed while descending, so it runs in O(log n)
 func (sl *SkipList) GetRank(score float64, member string) int {
 	rank := 0
-	current := sl.header.Next[0] // Start at first node in level 0
+	current := sl.header
 
-	// Traverse level 0 and count nodes until we find the target
-	for current != nil {
-		if current.Score == score && current.Member == member {
-			return rank // Found it
+	for i := sl.level; i >= 0; i-- {
+		for current.Level[i].Forward != nil &&
+			(nodeBefore(current.Level[i].Forward, score, member) ||
+				(current.Level[i].Forward.Score == score && current.Level[i].Forward.Member == member)) {
+			rank += current.Level[i].Span
+			current = current.Level[i].Forward
 		}
-		// If we've passed where it should be, it doesn't exist
-		if current.Score > score || (current.Score == score && current.Member > member) {
-			return -1
+		// current is the last node at or before the target
+		if current != sl.header && current.Score == score && current.Member == member {
+			return rank - 1
 		}
-		rank++
-		current = current.Next[0]
 	}
 
 	return -1 // Not found
 }
 
-// GetRange returns members in the given rank range [start, stop] (0-based, inclusive)
-func (sl *SkipList) GetRange(start, stop int) []*SkipListNode {
+// nodeByRank returns the node at the given 0-based rank in O(log n)
+func (sl *SkipList) nodeByRank(rank int) *SkipListNode {
+	traversed := 0
+	target := rank + 1
+	current := sl.header
+
+	for i := sl.level; i >= 0; i-- {
+		for current.Level[i].Forward != nil && traversed+current.Level[i].Span <= target {
+			traversed += current.Level[i].Span
+			current = current.Level[i].Forward
+		}
+		if traversed == target {
+			return current
+		}
+	}
+	return nil
+}
+
+// normalizeRange converts a rank range that may use negative indexes into offsets
+// from the start, reporting false when it selects nothing
+func (sl *SkipList) normalizeRange(start, stop int) (int, int, bool) {
 	if start < 0 {
 		start = sl.length + start
 		if start < 0 {
@@ -166,30 +226,194 @@ func (sl *SkipList) GetRange(start, stop int) []*SkipListNode {
 		}
 	}
 	if start > stop || start >= sl.length {
-		return []*SkipListNode{}
+		return 0, 0, false
 	}
 	if stop >= sl.length {
 		stop = sl.length - 1
 	}
+	return start, stop, true
+}
 
-	result := make([]*SkipListNode, 0, stop-start+1)
-	current := sl.header.Next[0]
+// GetRange returns members in the given rank range [start, stop] (0-based, inclusive)
+func (sl *SkipList) GetRange(start, stop int) []*SkipListNode {
+	start, stop, ok := sl.normalizeRange(start, stop)
+	if !ok {
+		return []*SkipListNode{}
+	}
+	return sl.collect(start, stop-start+1, false)
+}
 
-	// Skip to start position
-	for i := 0; i < start && current != nil; i++ {
-		current = current.Next[0]
+// GetRevRange is like GetRange with ranks counted from the highest score down
+func (sl *SkipList) GetRevRange(start, stop int) []*SkipListNode {
+	start, stop, ok := sl.normalizeRange(start, stop)
+	if !ok {
+		return []*SkipListNode{}
 	}
+	return sl.collect(sl.length-1-start, stop-start+1, true)
+}
 
-	// Collect nodes from start to stop
-	for i := start; i <= stop && current != nil; i++ {
+// collect returns n nodes starting at rank from, walking backwards with reverse
+func (sl *SkipList) collect(from, n int, reverse bool) []*SkipListNode {
+	result := make([]*SkipListNode, 0, n)
+	current := sl.nodeByRank(from)
+	for ; n > 0 && current != nil; n-- {
 		result = append(result, current)
-		current = current.Next[0]
+		if reverse {
+			current = current.Backward
+		} else {
+			current = current.Level[0].Forward
+		}
 	}
-
 	return result
 }
 
+// First returns the node with the lowest score, or nil if the list is empty
+func (sl *SkipList) First() *SkipListNode {
+	return sl.header.Level[0].Forward
+}
+
+// Last returns the node with the highest score, or nil if the list is empty
+func (sl *SkipList) Last() *SkipListNode {
+	return sl.tail
+}
+
 // Length returns the number of elements in the skip list
 func (sl *SkipList) Length() int {
 	return sl.length
 }
+
+// ScoreBound is one end of a score range, such as 5, (5 or +inf
+type ScoreBound struct {
+	Value     float64
+	Exclusive bool
+}
+
+// LexBound is one end of a lexicographic range, such as [a, (a, - or +
+type LexBound struct {
+	Value     string
+	Exclusive bool
+	Infinite  int // -1 for "-" (before every member), 1 for "+" (after every member)
+}
+
+// scoreAboveMin reports whether score is not below the lower bound min
+func scoreAboveMin(score float64, min ScoreBound) bool {
+	if min.Exclusive {
+		return score > min.Value
+	}
+	return score >= min.Value
+}
+
+// scoreBelowMax reports whether score is not above the upper bound max
+func scoreBelowMax(score float64, max ScoreBound) bool {
+	if max.Exclusive {
+		return score < max.Value
+	}
+	return score <= max.Value
+}
+
+// lexAboveMin reports whether member is not below the lower bound min
+func lexAboveMin(member string, min LexBound) bool {
+	switch {
+	case min.Infinite < 0:
+		return true
+	case min.Infinite > 0:
+		return false
+	case min.Exclusive:
+		return member > min.Value
+	default:
+		return member >= min.Value
+	}
+}
+
+// lexBelowMax reports whether member is not above the upper bound max
+func lexBelowMax(member string, max LexBound) bool {
+	switch {
+	case max.Infinite > 0:
+		return true
+	case max.Infinite < 0:
+		return false
+	case max.Exclusive:
+		return member < max.Value
+	default:
+		return member <= max.Value
+	}
+}
+
+// countWhile returns how many leading nodes satisfy pred
+// pred must hold for a prefix of the list and fail for the rest, which lets the
+// search descend the levels and add up spans in O(log n)
+func (sl *SkipList) countWhile(pred func(node *SkipListNode) bool) int {
+	traversed := 0
+	current := sl.header
+	for i := sl.level; i >= 0; i-- {
+		for current.Level[i].Forward != nil && pred(current.Level[i].Forward) {
+			traversed += current.Level[i].Span
+			current = current.Level[i].Forward
+		}
+	}
+	return traversed
+}
+
+// rangeBetween returns the nodes with ranks first to last, in ascending order or
+// descending with reverse, skipping offset of them and returning at most count
+// (all when count is negative)
+func (sl *SkipList) rangeBetween(first, last int, reverse bool, offset, count int) []*SkipListNode {
+	n := last - first + 1 - offset
+	if offset < 0 || n <= 0 {
+		return []*SkipListNode{}
+	}
+	if count >= 0 && count < n {
+		n = count
+	}
+	if reverse {
+		return sl.collect(last-offset, n, true)
+	}
+	return sl.collect(first+offset, n, false)
+}
+
+// scoreRanks returns the ranks of the first and last nodes whose score lies between min and max
+func (sl *SkipList) scoreRanks(min, max ScoreBound) (int, int) {
+	first := sl.countWhile(func(node *SkipListNode) bool { return !scoreAboveMin(node.Score, min) })
+	last := sl.countWhile(func(node *SkipListNode) bool { return scoreBelowMax(node.Score, max) }) - 1
+	return first, last
+}
+
+// lexRanks returns the ranks of the first and last nodes whose member lies between min and max
+func (sl *SkipList) lexRanks(min, max LexBound) (int, int) {
+	first := sl.countWhile(func(node *SkipListNode) bool { return !lexAboveMin(node.Member, min) })
+	last := sl.countWhile(func(node *SkipListNode) bool { return lexBelowMax(node.Member, max) }) - 1
+	return first, last
+}
+
+// RangeByScore returns the nodes whose score lies between min and max, in ascending
+// order or descending with reverse, skipping offset nodes and returning at most count
+// (all when count is negative)
+func (sl *SkipList) RangeByScore(min, max ScoreBound, reverse bool, offset, count int) []*SkipListNode {
+	first, last := sl.scoreRanks(min, max)
+	return sl.rangeBetween(first, last, reverse, offset, count)
+}
+
+// RangeByLex is like RangeByScore for a member range
+// As in Redis, the result is only meaningful when every member has the same score.
+func (sl *SkipList) RangeByLex(min, max LexBound, reverse bool, offset, count int) []*SkipListNode {
+	first, last := sl.lexRanks(min, max)
+	return sl.rangeBetween(first, last, reverse, offset, count)
+}
+
+// CountByScore returns the number of nodes whose score lies between min and max
+func (sl *SkipList) CountByScore(min, max ScoreBound) int {
+	first, last := sl.scoreRanks(min, max)
+	if last < first {
+		return 0
+	}
+	return last - first + 1
+}
+
+// CountByLex returns the number of nodes whose member lies between min and max
+func (sl *SkipList) CountByLex(min, max LexBound) int {
+	first, last := sl.lexRanks(min, max)
+	if last < first {
+		return 0
+	}
+	return last - first + 1
+}
@@ -4,6 +4,7 @@ package store
 type SortedSet struct {
 	dict     map[string]float64 // member -> score for O(1) lookup
 	skipList *SkipList          // skip list for ordered operations
+	order    *ScanOrder         // built by the first ZSCAN
 }
 
 // NewSortedSet creates a new sorted set
@@ -33,6 +34,7 @@ func (zs *SortedSet) Add(score float64, member string) bool {
 	// Add to skip list
 	zs.skipList.Insert(score, member)
 
+	zs.order.add(member)
 	return !exists
 }
 
@@ -46,9 +48,34 @@ func (zs *SortedSet) Remove(member string) bool {
 
 	delete(zs.dict, member)
 	zs.skipList.Delete(score, member)
+	zs.order.remove(member)
 	return true
 }
 
+// PopMin removes and returns up to count members with the lowest scores, lowest first
+func (zs *SortedSet) PopMin(count int) []ScoredMember {
+	return zs.pop(count, zs.skipList.First)
+}
+
+// PopMax removes and returns up to count members with the highest scores, highest first
+func (zs *SortedSet) PopMax(count int) []ScoredMember {
+	return zs.pop(count, zs.skipList.Last)
+}
+
+// pop removes the node returned by next until count members are popped or the set is empty
+func (zs *SortedSet) pop(count int, next func() *SkipListNode) []ScoredMember {
+	result := []ScoredMember{}
+	for ; count > 0; count-- {
+		node := next()
+		if node == nil {
+			break
+		}
+		result = append(result, ScoredMember{Member: node.Member, Score: node.Score})
+		zs.Remove(node.Member)
+	}
+	return result
+}
+
 // GetScore returns the score of a member
 func (zs *SortedSet) GetScore(member string) (float64, bool) {
 	score, exists := zs.dict[member]
@@ -79,24 +106,59 @@ func (zs *SortedSet) GetRange(start, stop int) []string {
 	return result
 }
 
-// GetRangeWithScores returns members with scores in the given rank range
-func (zs *SortedSet) GetRangeWithScores(start, stop int) []struct {
+// ScanOrder returns the order in which ZSCAN visits the members
+// It starts in rank order and is kept up to date as members are added and removed.
+func (zs *SortedSet) ScanOrder() *ScanOrder {
+	if zs.order == nil {
+		zs.order = newScanOrder(zs.GetRange(0, -1))
+	}
+	return zs.order
+}
+
+// ScoredMember is a sorted set member together with its score
+type ScoredMember struct {
 	Member string
 	Score  float64
-} {
-	nodes := zs.skipList.GetRange(start, stop)
-	result := make([]struct {
-		Member string
-		Score  float64
-	}, len(nodes))
+}
+
+// GetRangeWithScores returns members with scores in the given rank range
+func (zs *SortedSet) GetRangeWithScores(start, stop int) []ScoredMember {
+	return scoredMembers(zs.skipList.GetRange(start, stop))
+}
+
+// GetRevRangeWithScores returns members with scores in the given rank range,
+// ranks counting from the highest score down
+func (zs *SortedSet) GetRevRangeWithScores(start, stop int) []ScoredMember {
+	return scoredMembers(zs.skipList.GetRevRange(start, stop))
+}
+
+// RangeByScore returns members with scores between min and max, in ascending order
+// (descending with reverse), skipping offset of them and returning at most count
+// (all when count is negative)
+func (zs *SortedSet) RangeByScore(min, max ScoreBound, reverse bool, offset, count int) []ScoredMember {
+	return scoredMembers(zs.skipList.RangeByScore(min, max, reverse, offset, count))
+}
+
+// RangeByLex returns members between min and max in lexicographic order
+// Only meaningful when all members have the same score.
+func (zs *SortedSet) RangeByLex(min, max LexBound, reverse bool, offset, count int) []ScoredMember {
+	return scoredMembers(zs.skipList.RangeByLex(min, max, reverse, offset, count))
+}
+
+// CountByScore returns the number of members with scores between min and max
+func (zs *SortedSet) CountByScore(min, max ScoreBound) int {
+	return zs.skipList.CountByScore(min, max)
+}
+
+// CountByLex returns the number of members between min and max in lexicographic order
+func (zs *SortedSet) CountByLex(min, max LexBound) int {
+	return zs.skipList.CountByLex(min, max)
+}
+
+func scoredMembers(nodes []*SkipListNode) []ScoredMember {
+	result := make([]ScoredMember, len(nodes))
 	for i, node := range nodes {
-		result[i] = struct {
-			Member string
-			Score  float64
-		}{
-			Member: node.Member,
-			Score:  node.Score,
-		}
+		result[i] = ScoredMember{Member: node.Member, Score: node.Score}
 	}
 	return result
 }
@@ -0,0 +1,294 @@
+package store
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// StreamID identifies a stream entry: a millisecond timestamp and a sequence number within it
+type StreamID struct {
+	Ms  uint64
+	Seq uint64
+}
+
+// ParseStreamID parses an ID of the form ms-seq, or a bare ms whose sequence defaults to defaultSeq
+func ParseStreamID(s string, defaultSeq uint64) (StreamID, bool) {
+	msPart, seqPart, hasSeq := strings.Cut(s, "-")
+	ms, err := strconv.ParseUint(msPart, 10, 64)
+	if err != nil {
+		return StreamID{}, false
+	}
+	if !hasSeq {
+		return StreamID{Ms: ms, Seq: defaultSeq}, true
+	}
+	seq, err := strconv.ParseUint(seqPart, 10, 64)
+	if err != nil {
+		return StreamID{}, false
+	}
+	return StreamID{Ms: ms, Seq: seq}, true
+}
+
+// MaxStreamID is the greatest possible stream ID
+var MaxStreamID = StreamID{Ms: math.MaxUint64, Seq: math.MaxUint64}
+
+// Next returns the ID following id, and false if id is MaxStreamID
+func (id StreamID) Next() (StreamID, bool) {
+	switch {
+	case id.Seq < math.MaxUint64:
+		return StreamID{Ms: id.Ms, Seq: id.Seq + 1}, true
+	case id.Ms < math.MaxUint64:
+		return StreamID{Ms: id.Ms + 1}, true
+	default:
+		return id, false
+	}
+}
+
+// Prev returns the ID preceding id, and false if id is 0-0
+func (id StreamID) Prev() (StreamID, bool) {
+	switch {
+	case id.Seq > 0:
+		return StreamID{Ms: id.Ms, Seq: id.Seq - 1}, true
+	case id.Ms > 0:
+		return StreamID{Ms: id.Ms - 1, Seq: math.MaxUint64}, true
+	default:
+		return id, false
+	}
+}
+
+func (id StreamID) String() string {
+	return strconv.FormatUint(id.Ms, 10) + "-" + strconv.FormatUint(id.Seq, 10)
+}
+
+// Compare returns -1, 0 or 1 as id sorts before, equal to or after other
+func (id StreamID) Compare(other StreamID) int {
+	switch {
+	case id.Ms < other.Ms || (id.Ms == other.Ms && id.Seq < other.Seq):
+		return -1
+	case id == other:
+		return 0
+	default:
+		return 1
+	}
+}
+
+// ConsumerGroup tracks which entries of a stream were delivered to its consumers
+// and which of those are still waiting to be acknowledged
+type ConsumerGroup struct {
+	Name          string
+	LastDelivered StreamID
+	EntriesRead   int64        // how many entries of the stream the group read, or UnknownEntriesRead
+	Pending       *PendingList // the group's pending entries list (PEL)
+	Consumers     map[string]*Consumer
+}
+
+// UnknownEntriesRead marks a group whose read counter cannot be derived, as when its
+// last delivered ID was set by hand on a stream with deleted entries
+const UnknownEntriesRead = -1
+
+// Consumer is a member of a consumer group
+type Consumer struct {
+	Name    string
+	SeenAt  time.Time    // last time the consumer read from the group
+	Pending *PendingList // the entries of the group PEL owned by this consumer
+}
+
+// PendingEntry is an entry delivered to a consumer but not yet acknowledged
+type PendingEntry struct {
+	ID            StreamID
+	Consumer      *Consumer
+	DeliveredAt   time.Time
+	DeliveryCount int64
+}
+
+// Group returns the consumer group called name, or nil if there is none
+func (s *Stream) Group(name string) *ConsumerGroup {
+	return s.Groups[name]
+}
+
+// CreateGroup adds a consumer group that will deliver entries after lastDelivered
+// Returns false if a group with that name already exists
+func (s *Stream) CreateGroup(name string, lastDelivered StreamID) bool {
+	if _, exists := s.Groups[name]; exists {
+		return false
+	}
+	if s.Groups == nil {
+		s.Groups = make(map[string]*ConsumerGroup)
+	}
+	s.Groups[name] = &ConsumerGroup{
+		Name:          name,
+		LastDelivered: lastDelivered,
+		EntriesRead:   UnknownEntriesRead,
+		Pending:       NewPendingList(),
+		Consumers:     make(map[string]*Consumer),
+	}
+	return true
+}
+
+// DestroyGroup removes the consumer group called name along with its pending entries
+// Returns false if there was no such group
+func (s *Stream) DestroyGroup(name string) bool {
+	if _, exists := s.Groups[name]; !exists {
+		return false
+	}
+	delete(s.Groups, name)
+	return true
+}
+
+// GroupNames returns the names of the stream's consumer groups in lexicographic order
+func (s *Stream) GroupNames() []string {
+	names := make([]string, 0, len(s.Groups))
+	for name := range s.Groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// SetID moves the last delivered ID of the group, which forgets how many entries it read
+func (g *ConsumerGroup) SetID(id StreamID) {
+	g.LastDelivered = id
+	g.EntriesRead = UnknownEntriesRead
+}
+
+// MarkRead records that the group read the entry id, the next one after its last delivered ID
+func (s *Stream) MarkRead(g *ConsumerGroup, id StreamID) {
+	g.LastDelivered = id
+	if g.EntriesRead != UnknownEntriesRead && !s.hasTombstonesFrom(id) {
+		g.EntriesRead++
+	} else if s.EntriesAdded > 0 {
+		g.EntriesRead = s.entriesUpTo(id)
+	}
+}
+
+// Lag returns how many entries of the stream the group has yet to read, and false when
+// that cannot be told because of deleted entries
+func (s *Stream) Lag(g *ConsumerGroup) (int64, bool) {
+	if s.EntriesAdded == 0 {
+		return 0, true
+	}
+	if g.EntriesRead != UnknownEntriesRead && !s.hasTombstonesFrom(g.LastDelivered) {
+		return s.EntriesAdded - g.EntriesRead, true
+	}
+	read := s.entriesUpTo(g.LastDelivered)
+	if read == UnknownEntriesRead {
+		return 0, false
+	}
+	return s.EntriesAdded - read, true
+}
+
+// entriesUpTo returns how many entries were added to the stream up to and including id,
+// or UnknownEntriesRead when deleted entries make that impossible to tell
+func (s *Stream) entriesUpTo(id StreamID) int64 {
+	switch cmp := id.Compare(s.LastID); {
+	case s.EntriesAdded == 0:
+		return 0
+	case s.length == 0 && cmp <= 0, cmp == 0:
+		return s.EntriesAdded
+	case cmp > 0:
+		return UnknownEntriesRead
+	}
+
+	// Only when no entry after the first one was deleted is the count known: everything
+	// before the first entry was added and then removed
+	first := s.First().ID
+	if s.MaxDeletedID == (StreamID{}) || s.MaxDeletedID.Compare(first) < 0 {
+		switch id.Compare(first) {
+		case -1:
+			return s.EntriesAdded - int64(s.length)
+		case 0:
+			return s.EntriesAdded - int64(s.length) + 1
+		}
+	}
+	return UnknownEntriesRead
+}
+
+// hasTombstonesFrom reports whether an entry with an ID from id up to the last one was deleted
+func (s *Stream) hasTombstonesFrom(id StreamID) bool {
+	if s.length == 0 || s.MaxDeletedID == (StreamID{}) {
+		return false
+	}
+	return id.Compare(s.MaxDeletedID) <= 0 && s.MaxDeletedID.Compare(s.LastID) <= 0
+}
+
+// CreateConsumer adds a consumer called name to the group
+// Returns false if it already exists
+func (g *ConsumerGroup) CreateConsumer(name string, now time.Time) bool {
+	if _, exists := g.Consumers[name]; exists {
+		return false
+	}
+	g.Consumers[name] = &Consumer{Name: name, SeenAt: now, Pending: NewPendingList()}
+	return true
+}
+
+// Consumer returns the consumer called name, creating it if needed
+func (g *ConsumerGroup) Consumer(name string, now time.Time) *Consumer {
+	g.CreateConsumer(name, now)
+	return g.Consumers[name]
+}
+
+// DeleteConsumer removes the consumer called name, dropping the entries pending for it
+// Returns how many entries were pending, and false if there was no such consumer
+func (g *ConsumerGroup) DeleteConsumer(name string) (int, bool) {
+	consumer, exists := g.Consumers[name]
+	if !exists {
+		return 0, false
+	}
+	consumer.Pending.Range(StreamID{}, func(pending *PendingEntry) bool {
+		g.Pending.Remove(pending.ID)
+		return true
+	})
+	delete(g.Consumers, name)
+	return consumer.Pending.Len(), true
+}
+
+// ConsumerNames returns the names of the group's consumers in lexicographic order
+func (g *ConsumerGroup) ConsumerNames() []string {
+	names := make([]string, 0, len(g.Consumers))
+	for name := range g.Consumers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Deliver records that the entry id was delivered to consumer, which becomes its owner
+func (g *ConsumerGroup) Deliver(consumer *Consumer, id StreamID, now time.Time) {
+	pending := g.Claim(consumer, id, now)
+	pending.DeliveryCount++
+}
+
+// Claim makes consumer the owner of the entry id, adding it to the pending entries list
+// if needed, without counting a delivery
+func (g *ConsumerGroup) Claim(consumer *Consumer, id StreamID, deliveredAt time.Time) *PendingEntry {
+	pending, exists := g.Pending.Get(id)
+	if !exists {
+		pending = &PendingEntry{ID: id}
+		g.Pending.Add(pending)
+	} else {
+		pending.Consumer.Pending.Remove(id)
+	}
+	pending.Consumer = consumer
+	pending.DeliveredAt = deliveredAt
+	consumer.Pending.Add(pending)
+	return pending
+}
+
+// Ack removes the entry id from the pending entries list
+// Returns false if it was not pending
+func (g *ConsumerGroup) Ack(id StreamID) bool {
+	pending, exists := g.Pending.Get(id)
+	if !exists {
+		return false
+	}
+	g.Pending.Remove(id)
+	pending.Consumer.Pending.Remove(id)
+	return true
+}
+
+// Idle returns how long ago the entry was last delivered
+func (p *PendingEntry) Idle(now time.Time) time.Duration {
+	return now.Sub(p.DeliveredAt)
+}
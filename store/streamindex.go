@@ -0,0 +1,218 @@
+package store
+
+import (
+	"slices"
+	"sort"
+)
+
+// StreamNodeSize is how many entries a node of a stream holds at most
+// Approximate trimming only ever removes whole nodes, which may leave a few more entries
+// than asked for.
+const StreamNodeSize = 100
+
+// streamNode is a chunk of consecutive stream entries in ID order
+// Nodes are filled up to StreamNodeSize by appends and shrink as entries are deleted,
+// but are never left empty.
+type streamNode struct {
+	entries []*StreamEntry
+}
+
+// NewStream creates a stream holding the given entries, which must be in ID order
+func NewStream(entries ...*StreamEntry) *Stream {
+	s := &Stream{}
+	for _, entry := range entries {
+		s.Append(entry)
+	}
+	return s
+}
+
+// Len returns the number of entries in the stream
+func (s *Stream) Len() int {
+	return s.length
+}
+
+// Append adds an entry whose ID is greater than that of every entry in the stream
+func (s *Stream) Append(entry *StreamEntry) {
+	if len(s.nodes) == 0 || len(s.nodes[len(s.nodes)-1].entries) >= StreamNodeSize {
+		s.nodes = append(s.nodes, &streamNode{entries: make([]*StreamEntry, 0, StreamNodeSize)})
+	}
+	last := s.nodes[len(s.nodes)-1]
+	last.entries = append(last.entries, entry)
+	s.length++
+	s.EntriesAdded++
+
+	if entry.ID.Compare(s.LastID) > 0 {
+		s.LastID = entry.ID
+	}
+}
+
+// seek returns the position of the first entry with an ID greater than or equal to id,
+// by binary search over the nodes and then within the node
+// The node index is len(s.nodes) when there is no such entry.
+func (s *Stream) seek(id StreamID) (int, int) {
+	n := sort.Search(len(s.nodes), func(i int) bool {
+		entries := s.nodes[i].entries
+		return entries[len(entries)-1].ID.Compare(id) >= 0
+	})
+	if n == len(s.nodes) {
+		return n, 0
+	}
+	entries := s.nodes[n].entries
+	e := sort.Search(len(entries), func(j int) bool {
+		return entries[j].ID.Compare(id) >= 0
+	})
+	return n, e
+}
+
+// Lookup returns the entry with the given ID, or nil if there is none
+func (s *Stream) Lookup(id StreamID) *StreamEntry {
+	n, e := s.seek(id)
+	if n < len(s.nodes) && s.nodes[n].entries[e].ID == id {
+		return s.nodes[n].entries[e]
+	}
+	return nil
+}
+
+// Range returns the entries with IDs between start and end inclusive, in ID order,
+// at most count of them (all when count <= 0)
+func (s *Stream) Range(start, end StreamID, count int) []*StreamEntry {
+	entries := []*StreamEntry{}
+	for n, e := s.seek(start); n < len(s.nodes); n, e = n+1, 0 {
+		for _, entry := range s.nodes[n].entries[e:] {
+			if entry.ID.Compare(end) > 0 {
+				return entries
+			}
+			entries = append(entries, entry)
+			if count > 0 && len(entries) == count {
+				return entries
+			}
+		}
+	}
+	return entries
+}
+
+// RevRange returns the entries with IDs between start and end inclusive, newest first,
+// at most count of them (all when count <= 0)
+func (s *Stream) RevRange(start, end StreamID, count int) []*StreamEntry {
+	entries := []*StreamEntry{}
+
+	// Step back from the first entry past end
+	n, e := s.seek(end)
+	if n < len(s.nodes) && s.nodes[n].entries[e].ID == end {
+		e++
+	}
+	for ; n >= 0; n-- {
+		if n < len(s.nodes) {
+			node := s.nodes[n].entries
+			for i := min(e, len(node)) - 1; i >= 0; i-- {
+				if node[i].ID.Compare(start) < 0 {
+					return entries
+				}
+				entries = append(entries, node[i])
+				if count > 0 && len(entries) == count {
+					return entries
+				}
+			}
+		}
+		e = StreamNodeSize
+	}
+	return entries
+}
+
+// First returns the oldest entry of the stream, or nil if it is empty
+func (s *Stream) First() *StreamEntry {
+	if len(s.nodes) == 0 {
+		return nil
+	}
+	return s.nodes[0].entries[0]
+}
+
+// Last returns the newest entry of the stream, or nil if it is empty
+func (s *Stream) Last() *StreamEntry {
+	if len(s.nodes) == 0 {
+		return nil
+	}
+	last := s.nodes[len(s.nodes)-1].entries
+	return last[len(last)-1]
+}
+
+// After returns the entries with IDs greater than id, at most count of them (all when count <= 0)
+func (s *Stream) After(id StreamID, count int) []*StreamEntry {
+	start, ok := id.Next()
+	if !ok {
+		return []*StreamEntry{}
+	}
+	return s.Range(start, MaxStreamID, count)
+}
+
+// Entries returns every entry of the stream in ID order
+func (s *Stream) Entries() []*StreamEntry {
+	return s.Range(StreamID{}, MaxStreamID, 0)
+}
+
+// Delete removes the entry with the given ID, remembering it as MaxDeletedID if greatest
+// Returns false if there was none
+func (s *Stream) Delete(id StreamID) bool {
+	n, e := s.seek(id)
+	if n == len(s.nodes) || s.nodes[n].entries[e].ID != id {
+		return false
+	}
+	if id.Compare(s.MaxDeletedID) > 0 {
+		s.MaxDeletedID = id
+	}
+
+	node := s.nodes[n]
+	node.entries = slices.Delete(node.entries, e, e+1)
+	if len(node.entries) == 0 {
+		s.nodes = slices.Delete(s.nodes, n, n+1)
+	}
+	s.length--
+	return true
+}
+
+// TrimMaxLen removes the oldest entries until at most maxLen remain, returning how many were removed
+// approx and limit behave as in trimOldest.
+func (s *Stream) TrimMaxLen(maxLen int, approx bool, limit int) int {
+	return s.trimOldest(s.length-maxLen, approx, limit)
+}
+
+// TrimMinID removes the entries with IDs lower than minID, returning how many were removed
+// approx and limit behave as in trimOldest.
+func (s *Stream) TrimMinID(minID StreamID, approx bool, limit int) int {
+	n, e := s.seek(minID)
+	older := e
+	for _, node := range s.nodes[:n] {
+		older += len(node.entries)
+	}
+	return s.trimOldest(older, approx, limit)
+}
+
+// trimOldest removes the n oldest entries, or at most limit of them when limit > 0
+// When approx is set only whole nodes are removed.
+func (s *Stream) trimOldest(n int, approx bool, limit int) int {
+	if limit > 0 {
+		n = min(n, limit)
+	}
+
+	removed := 0
+	for len(s.nodes) > 0 && removed < n {
+		node := s.nodes[0]
+		if removed+len(node.entries) <= n {
+			removed += len(node.entries)
+			s.nodes[0] = nil
+			s.nodes = s.nodes[1:]
+			continue
+		}
+		if approx {
+			break
+		}
+
+		cut := n - removed
+		clear(node.entries[:cut])
+		node.entries = node.entries[cut:]
+		removed = n
+	}
+
+	s.length -= removed
+	return removed
+}
@@ -38,8 +38,8 @@ func TestBLPopCommand(t *testing.T) {
 				if !exists {
 					t.Fatal("Expected list to exist")
 				}
-				if len(val.ListData) != 2 {
-					t.Errorf("Expected 2 elements remaining, got %d", len(val.ListData))
+				if val.ListData.Len() != 2 {
+					t.Errorf("Expected 2 elements remaining, got %d", val.ListData.Len())
 				}
 			},
 		},
@@ -60,7 +60,17 @@ func TestBLPopCommand(t *testing.T) {
 				store.Delete("emptylist")
 			},
 			args:     []string{"emptylist", "0.1"},
-			expected: "$-1\r\n",
+			expected: "*-1\r\n",
+		},
+		{
+			name: "Error on wrong type",
+			setup: func() {
+				store.Delete("mystring")
+				cli := setupBLPopTestClient()
+				command.New("set", []string{"mystring", "value"}).Execute(cli)
+			},
+			args:     []string{"mystring", "0.5"},
+			expected: "-WRONGTYPE Operation against a key holding the wrong kind of value\r\n",
 		},
 		{
 			name: "Pop last element deletes key",
@@ -0,0 +1,137 @@
+package tests
+
+import (
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	command "github.com/SuchintK/GoDisKV/commands"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+func setupBRPopTestClient() *client.Client {
+	conn1, _ := net.Pipe()
+	cli := client.New(conn1)
+	return &cli
+}
+
+func TestBRPopCommand(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func()
+		args     []string
+		expected string
+	}{
+		{
+			name: "Pop tail of existing list immediately",
+			setup: func() {
+				store.Delete("mylist")
+				cli := setupBRPopTestClient()
+				command.New("rpush", []string{"mylist", "one", "two"}).Execute(cli)
+			},
+			args:     []string{"mylist", "1"},
+			expected: "*2\r\n$6\r\nmylist\r\n$3\r\ntwo\r\n",
+		},
+		{
+			name: "Pop from first non-empty list among multiple keys",
+			setup: func() {
+				store.Delete("list1")
+				store.Delete("list2")
+				cli := setupBRPopTestClient()
+				command.New("rpush", []string{"list2", "value"}).Execute(cli)
+			},
+			args:     []string{"list1", "list2", "1"},
+			expected: "*2\r\n$5\r\nlist2\r\n$5\r\nvalue\r\n",
+		},
+		{
+			name: "Null array on timeout",
+			setup: func() {
+				store.Delete("emptylist")
+			},
+			args:     []string{"emptylist", "0.1"},
+			expected: "*-1\r\n",
+		},
+		{
+			name: "Error on wrong type",
+			setup: func() {
+				store.Delete("mystring")
+				cli := setupBRPopTestClient()
+				command.New("set", []string{"mystring", "value"}).Execute(cli)
+			},
+			args:     []string{"mystring", "0.5"},
+			expected: "-WRONGTYPE Operation against a key holding the wrong kind of value\r\n",
+		},
+		{
+			name:     "Error on negative timeout",
+			setup:    func() {},
+			args:     []string{"mylist", "-1"},
+			expected: "-ERR timeout is negative\r\n",
+		},
+		{
+			name:     "Error on wrong number of arguments",
+			setup:    func() {},
+			args:     []string{"mylist"},
+			expected: "-wrong number of arguments\r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			cli := setupBRPopTestClient()
+			result := command.New("brpop", tt.args).Execute(cli)
+
+			if string(result) != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, string(result))
+			}
+		})
+	}
+}
+
+func TestBRPopReplicatesAsRPop(t *testing.T) {
+	store.Delete("mylist")
+	cli := setupBRPopTestClient()
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		producer := setupBRPopTestClient()
+		command.New("rpush", []string{"mylist", "job"}).Execute(producer)
+	}()
+
+	result := command.New("brpop", []string{"mylist", "1"}).Execute(cli)
+	if string(result) != "*2\r\n$6\r\nmylist\r\n$3\r\njob\r\n" {
+		t.Fatalf("Expected job to be popped, got %q", string(result))
+	}
+
+	expected := [][]string{{"rpop", "mylist"}}
+	if propagated := cli.TakePropagated(); !reflect.DeepEqual(propagated, expected) {
+		t.Errorf("Expected %v to be replicated, got %v", expected, propagated)
+	}
+}
+
+func TestBlockedClientsAreServedInOrder(t *testing.T) {
+	store.Delete("fifo")
+
+	first := make(chan string, 1)
+	second := make(chan string, 1)
+	go func() {
+		first <- string(command.New("brpop", []string{"fifo", "2"}).Execute(setupBRPopTestClient()))
+	}()
+	time.Sleep(100 * time.Millisecond)
+	go func() {
+		second <- string(command.New("blpop", []string{"fifo", "0.5"}).Execute(setupBRPopTestClient()))
+	}()
+	time.Sleep(100 * time.Millisecond)
+
+	producer := setupBRPopTestClient()
+	command.New("rpush", []string{"fifo", "only"}).Execute(producer)
+
+	if got := <-first; got != "*2\r\n$4\r\nfifo\r\n$4\r\nonly\r\n" {
+		t.Errorf("Expected the first blocked client to be served, got %q", got)
+	}
+	if got := <-second; got != "*-1\r\n" {
+		t.Errorf("Expected the second blocked client to time out, got %q", got)
+	}
+}
@@ -0,0 +1,105 @@
+package tests
+
+import (
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	command "github.com/SuchintK/GoDisKV/commands"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+func setupBZPopMinTestClient() *client.Client {
+	conn1, _ := net.Pipe()
+	cli := client.New(conn1)
+	return &cli
+}
+
+func TestBZPopMinImmediate(t *testing.T) {
+	store.Delete("queue1")
+	store.Delete("queue2")
+	cli := setupBZPopMinTestClient()
+	command.New("zadd", []string{"queue2", "5", "job", "1", "urgent"}).Execute(cli)
+
+	result := command.New("bzpopmin", []string{"queue1", "queue2", "1"}).Execute(cli)
+	expected := "*3\r\n$6\r\nqueue2\r\n$6\r\nurgent\r\n$1\r\n1\r\n"
+	if string(result) != expected {
+		t.Errorf("Expected %q, got %q", expected, string(result))
+	}
+}
+
+func TestBZPopMaxTimeout(t *testing.T) {
+	store.Delete("queue1")
+	cli := setupBZPopMinTestClient()
+
+	start := time.Now()
+	result := command.New("bzpopmax", []string{"queue1", "0.1"}).Execute(cli)
+	elapsed := time.Since(start)
+
+	if string(result) != "*-1\r\n" {
+		t.Errorf("Expected null array on timeout, got %q", string(result))
+	}
+	if elapsed < 100*time.Millisecond {
+		t.Errorf("Expected to wait at least 100ms, but took %v", elapsed)
+	}
+	if len(cli.TakePropagated()) != 0 {
+		t.Error("Expected nothing to be replicated on timeout")
+	}
+}
+
+func TestBZPopMaxUnblocksAndReplicatesAsZPopMax(t *testing.T) {
+	store.Delete("queue1")
+	cli := setupBZPopMinTestClient()
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		producer := setupBZPopMinTestClient()
+		command.New("zadd", []string{"queue1", "1", "low", "9", "high"}).Execute(producer)
+	}()
+
+	result := command.New("bzpopmax", []string{"queue1", "1"}).Execute(cli)
+	expected := "*3\r\n$6\r\nqueue1\r\n$4\r\nhigh\r\n$1\r\n9\r\n"
+	if string(result) != expected {
+		t.Fatalf("Expected %q, got %q", expected, string(result))
+	}
+
+	propagated := [][]string{{"zpopmax", "queue1"}}
+	if got := cli.TakePropagated(); !reflect.DeepEqual(got, propagated) {
+		t.Errorf("Expected %v to be replicated, got %v", propagated, got)
+	}
+}
+
+func TestBZMPopUnblocksAndReplicatesAsZMPop(t *testing.T) {
+	store.Delete("queue1")
+	cli := setupBZPopMinTestClient()
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		producer := setupBZPopMinTestClient()
+		command.New("zadd", []string{"queue1", "1", "a", "2", "b", "3", "c"}).Execute(producer)
+	}()
+
+	result := command.New("bzmpop", []string{"1", "1", "queue1", "min", "count", "2"}).Execute(cli)
+	expected := "*2\r\n$6\r\nqueue1\r\n*2\r\n*2\r\n$1\r\na\r\n$1\r\n1\r\n*2\r\n$1\r\nb\r\n$1\r\n2\r\n"
+	if string(result) != expected {
+		t.Fatalf("Expected %q, got %q", expected, string(result))
+	}
+
+	propagated := [][]string{{"zmpop", "1", "queue1", "min", "count", "2"}}
+	if got := cli.TakePropagated(); !reflect.DeepEqual(got, propagated) {
+		t.Errorf("Expected %v to be replicated, got %v", propagated, got)
+	}
+}
+
+func TestBZPopMinWrongType(t *testing.T) {
+	store.Delete("notazset")
+	cli := setupBZPopMinTestClient()
+	command.New("set", []string{"notazset", "value"}).Execute(cli)
+
+	result := command.New("bzpopmin", []string{"notazset", "0"}).Execute(cli)
+	if string(result) != "-WRONGTYPE Operation against a key holding the wrong kind of value\r\n" {
+		t.Errorf("Expected WRONGTYPE error, got %q", string(result))
+	}
+}
@@ -0,0 +1,216 @@
+package tests
+
+import (
+	"bytes"
+	"net"
+	"testing"
+
+	command "github.com/SuchintK/GoDisKV/commands"
+	"github.com/SuchintK/GoDisKV/geofence"
+	"github.com/SuchintK/GoDisKV/pubsub"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+func setupGeoFenceTestClient() *client.Client {
+	conn1, _ := net.Pipe()
+	cli := client.New(conn1)
+	return &cli
+}
+
+// setupGeoFences registers a square "depot" around (2.35, 48.85) and a 1km "station" circle
+// around (2.37, 48.84) on the vehicles key
+func setupGeoFences(cli *client.Client) {
+	geofence.ResetGlobal()
+	store.Delete("vehicles")
+	command.New("geofence", []string{"add", "vehicles", "depot", "polygon", "2.34", "48.84", "2.36", "48.84", "2.36", "48.86", "2.34", "48.86"}).Execute(cli)
+	command.New("geofence", []string{"add", "vehicles", "station", "circle", "2.37", "48.84", "1", "km"}).Execute(cli)
+}
+
+func TestGeoFenceCommand(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		expected string
+	}{
+		{
+			name:     "Add a new fence",
+			args:     []string{"add", "vehicles", "yard", "polygon", "0", "0", "1", "0", "1", "1"},
+			expected: ":1\r\n",
+		},
+		{
+			name:     "Replace an existing fence",
+			args:     []string{"add", "vehicles", "depot", "circle", "2.35", "48.85", "500", "m"},
+			expected: ":0\r\n",
+		},
+		{
+			name:     "List fences",
+			args:     []string{"list", "vehicles"},
+			expected: "*2\r\n$5\r\ndepot\r\n$7\r\nstation\r\n",
+		},
+		{
+			name:     "Members inside a polygon",
+			args:     []string{"members", "vehicles", "depot"},
+			expected: "*2\r\n$5\r\nvan-1\r\n$5\r\nvan-2\r\n",
+		},
+		{
+			name:     "Members inside a circle",
+			args:     []string{"members", "vehicles", "station"},
+			expected: "*1\r\n$5\r\nvan-3\r\n",
+		},
+		{
+			name:     "Delete a fence",
+			args:     []string{"del", "vehicles", "station"},
+			expected: ":1\r\n",
+		},
+		{
+			name:     "Delete a missing fence",
+			args:     []string{"del", "vehicles", "nowhere"},
+			expected: ":0\r\n",
+		},
+		{
+			name:     "Error on members of a missing fence",
+			args:     []string{"members", "vehicles", "nowhere"},
+			expected: "-ERR no such geofence\r\n",
+		},
+		{
+			name:     "Error on polygon with two vertices",
+			args:     []string{"add", "vehicles", "line", "polygon", "0", "0", "1", "1"},
+			expected: "-ERR a polygon needs at least 3 vertices\r\n",
+		},
+		{
+			name:     "Error on odd polygon coordinates",
+			args:     []string{"add", "vehicles", "yard", "polygon", "0", "0", "1", "0", "1"},
+			expected: "-syntax error\r\n",
+		},
+		{
+			name:     "Error on unknown shape",
+			args:     []string{"add", "vehicles", "yard", "square", "0", "0"},
+			expected: "-syntax error\r\n",
+		},
+		{
+			name:     "Error on unknown subcommand",
+			args:     []string{"move", "vehicles"},
+			expected: "-ERR unknown subcommand 'move'\r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cli := setupGeoFenceTestClient()
+			setupGeoFences(cli)
+			command.New("geoadd", []string{"vehicles",
+				"2.345", "48.845", "van-1",
+				"2.355", "48.855", "van-2",
+				"2.372", "48.842", "van-3",
+				"2.5", "48.9", "van-4",
+			}).Execute(cli)
+
+			result := command.New("geofence", tt.args).Execute(cli)
+			if string(result) != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, string(result))
+			}
+		})
+	}
+}
+
+func TestGeoAddPublishesFenceCrossings(t *testing.T) {
+	pubsub.ResetGlobal()
+	cli := setupGeoFenceTestClient()
+	setupGeoFences(cli)
+
+	conn := &mockConn{Buffer: &bytes.Buffer{}}
+	subscriber := client.New(conn)
+	command.New("subscribe", []string{"geofence:vehicles:depot"}).Execute(&subscriber)
+	command.New("subscribe", []string{"geofence:vehicles:station"}).Execute(&subscriber)
+
+	steps := []struct {
+		name     string
+		lon      string
+		lat      string
+		expected string
+	}{
+		{
+			name:     "Appearing inside a fence enters it",
+			lon:      "2.345",
+			lat:      "48.845",
+			expected: "*3\r\n$7\r\nmessage\r\n$23\r\ngeofence:vehicles:depot\r\n$11\r\nenter van-1\r\n",
+		},
+		{
+			name:     "Moving within a fence publishes nothing",
+			lon:      "2.355",
+			lat:      "48.855",
+			expected: "",
+		},
+		{
+			name: "Moving between fences exits one and enters the other",
+			lon:  "2.37",
+			lat:  "48.84",
+			expected: "*3\r\n$7\r\nmessage\r\n$23\r\ngeofence:vehicles:depot\r\n$10\r\nexit van-1\r\n" +
+				"*3\r\n$7\r\nmessage\r\n$25\r\ngeofence:vehicles:station\r\n$11\r\nenter van-1\r\n",
+		},
+		{
+			name:     "Leaving every fence",
+			lon:      "2.5",
+			lat:      "48.9",
+			expected: "*3\r\n$7\r\nmessage\r\n$25\r\ngeofence:vehicles:station\r\n$10\r\nexit van-1\r\n",
+		},
+	}
+
+	for _, step := range steps {
+		t.Run(step.name, func(t *testing.T) {
+			conn.Reset()
+			command.New("geoadd", []string{"vehicles", step.lon, step.lat, "van-1"}).Execute(cli)
+			if conn.String() != step.expected {
+				t.Errorf("Expected %q, got %q", step.expected, conn.String())
+			}
+		})
+	}
+}
+
+func TestGeoFencesRemovedWithKey(t *testing.T) {
+	tests := []struct {
+		name   string
+		remove func(cli *client.Client)
+	}{
+		{
+			name: "Key deleted",
+			remove: func(cli *client.Client) {
+				store.Delete("vehicles")
+			},
+		},
+		{
+			name: "Last member popped",
+			remove: func(cli *client.Client) {
+				command.New("zpopmin", []string{"vehicles"}).Execute(cli)
+			},
+		},
+		{
+			name: "Key overwritten by another type",
+			remove: func(cli *client.Client) {
+				command.New("set", []string{"vehicles", "value"}).Execute(cli)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cli := setupGeoFenceTestClient()
+			setupGeoFences(cli)
+			command.New("geoadd", []string{"vehicles", "2.345", "48.845", "van-1"}).Execute(cli)
+
+			tt.remove(cli)
+
+			result := command.New("geofence", []string{"list", "vehicles"}).Execute(cli)
+			if string(result) != "*0\r\n" {
+				t.Errorf("Expected fences to be removed with the key, got %q", string(result))
+			}
+		})
+	}
+}
+
+func TestGeoAddIsReplicated(t *testing.T) {
+	if !command.IsWriteCommand("geoadd") {
+		t.Error("Expected GEOADD to be replicated, as GEOFENCE ADD is")
+	}
+}
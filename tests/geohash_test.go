@@ -0,0 +1,281 @@
+package tests
+
+import (
+	"math/rand"
+	"net"
+	"strconv"
+	"sync"
+	"testing"
+
+	command "github.com/SuchintK/GoDisKV/commands"
+	"github.com/SuchintK/GoDisKV/geohash"
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+func setupGeohashTestClient() *client.Client {
+	conn1, _ := net.Pipe()
+	cli := client.New(conn1)
+	return &cli
+}
+
+func TestCellScoreRangeHoldsItsPoints(t *testing.T) {
+	points := [][2]float64{{38.115556, 13.361389}, {-33.8688, 151.2093}, {85, 179.99}, {-85, -180}, {0, 0}}
+	for _, p := range points {
+		hash := geohash.Encode(p[0], p[1])
+		for step := 1; step <= geohash.MaxStep; step++ {
+			min, max := geohash.CellAt(p[0], p[1], step).ScoreRange()
+			if hash < min || hash >= max {
+				t.Fatalf("hash %d of %v outside step %d range [%d, %d)", hash, p, step, min, max)
+			}
+		}
+	}
+}
+
+func TestCellNeighbors(t *testing.T) {
+	tests := []struct {
+		name      string
+		lat, lon  float64
+		step      int
+		neighbors int
+	}{
+		{name: "Inner cell", lat: 38.1, lon: 13.3, step: 10, neighbors: 8},
+		{name: "Northernmost row", lat: 85, lon: 13.3, step: 10, neighbors: 5},
+		{name: "Southernmost row", lat: -85, lon: 13.3, step: 10, neighbors: 5},
+		{name: "Step 1 wraps onto the same column", lat: 10, lon: 10, step: 1, neighbors: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			neighbors := geohash.CellAt(tt.lat, tt.lon, tt.step).Neighbors()
+			if len(neighbors) != tt.neighbors {
+				t.Errorf("Expected %d neighbors, got %d", tt.neighbors, len(neighbors))
+			}
+		})
+	}
+}
+
+func TestCellNeighborsWrapAroundAntimeridian(t *testing.T) {
+	west := geohash.CellAt(10, -179.999, 12)
+	east := geohash.CellAt(10, 179.999, 12)
+
+	for _, neighbor := range west.Neighbors() {
+		if neighbor == east {
+			return
+		}
+	}
+	t.Errorf("Expected the westernmost cell to neighbor the easternmost one")
+}
+
+func TestEstimateStep(t *testing.T) {
+	tests := []struct {
+		name     string
+		radius   float64
+		latitude float64
+		expected int
+	}{
+		{name: "Zero radius uses full precision", radius: 0, latitude: 0, expected: geohash.MaxStep},
+		{name: "Small radius", radius: 100, latitude: 0, expected: 17},
+		{name: "Coarser near the poles", radius: 100, latitude: 70, expected: 16},
+		{name: "Coarser still past 80 degrees", radius: 100, latitude: -82, expected: 15},
+		{name: "Huge radius", radius: 20000000, latitude: 0, expected: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := geohash.EstimateStep(tt.radius, tt.latitude); got != tt.expected {
+				t.Errorf("Expected step %d, got %d", tt.expected, got)
+			}
+		})
+	}
+}
+
+// randomGeoPoint returns a point near one of the awkward places of the map, or anywhere
+func randomGeoPoint(rng *rand.Rand) (float64, float64) {
+	switch rng.Intn(4) {
+	case 0: // around the antimeridian
+		return rng.Float64()*170 - 85, 180 - rng.Float64()*2 - float64(rng.Intn(2))*358
+	case 1: // close to the latitude limits
+		return (84 + rng.Float64()) * float64(1-2*rng.Intn(2)), rng.Float64()*360 - 180
+	case 2: // a city-sized cluster
+		return 51.5 + rng.Float64()*0.2, -0.1 + rng.Float64()*0.2
+	default:
+		return rng.Float64()*170 - 85, rng.Float64()*360 - 180
+	}
+}
+
+// bruteForceGeoSearch returns the members of zset inside a circle (or a box when height is
+// set) around the center by measuring every member, in geohash order
+func bruteForceGeoSearch(zset *store.SortedSet, lat, lon, width, height float64) []string {
+	members := []string{}
+	for _, item := range zset.GetRangeWithScores(0, -1) {
+		memberLat, memberLon := geohash.Decode(uint64(item.Score))
+		if height > 0 {
+			if geohash.Distance(lat, lon, memberLat, lon) > height/2 || geohash.Distance(memberLat, lon, memberLat, memberLon) > width/2 {
+				continue
+			}
+		} else if geohash.Distance(lat, lon, memberLat, memberLon) > width {
+			continue
+		}
+		members = append(members, item.Member)
+	}
+	return members
+}
+
+// TestGeoSearchMatchesBruteForce checks that seeking the cells around the center finds
+// exactly the members a full scan would, for circles and boxes of every size
+func TestGeoSearchMatchesBruteForce(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	zset := store.NewSortedSet()
+	for i := 0; i < 10000; i++ {
+		lat, lon := randomGeoPoint(rng)
+		zset.Add(float64(geohash.Encode(lat, lon)), "v"+strconv.Itoa(i))
+	}
+	store.Set("fleet", &store.Value{SortedSetData: zset})
+	defer store.Delete("fleet")
+	cli := setupGeohashTestClient()
+
+	format := func(f float64) string { return strconv.FormatFloat(f, 'f', -1, 64) }
+
+	for i := 0; i < 200; i++ {
+		lat, lon := randomGeoPoint(rng)
+		size := float64(1+rng.Intn(10)) * []float64{1, 100, 10000, 1000000}[rng.Intn(4)]
+
+		args := []string{"fleet", "FROMLONLAT", format(lon), format(lat), "BYRADIUS", format(size), "m"}
+		expected := bruteForceGeoSearch(zset, lat, lon, size, 0)
+		if i%2 == 1 {
+			height := size * (0.5 + rng.Float64())
+			args = []string{"fleet", "FROMLONLAT", format(lon), format(lat), "BYBOX", format(size), format(height), "m"}
+			expected = bruteForceGeoSearch(zset, lat, lon, size, height)
+		}
+
+		items := make([][]byte, len(expected))
+		for j, member := range expected {
+			items[j] = resp.EncodeBulkString(member)
+		}
+		want := string(resp.EncodeArray(items))
+
+		if got := string(command.New("geosearch", args).Execute(cli)); got != want {
+			t.Fatalf("GEOSEARCH %v found %q, want %q", args, got, want)
+		}
+	}
+}
+
+const benchmarkFleetSize = 1000000
+
+var (
+	benchmarkFleet     *store.SortedSet
+	benchmarkFleetOnce sync.Once
+)
+
+// fleet returns one million vehicles spread over a 500km square, shared by the benchmarks
+func fleet() *store.SortedSet {
+	benchmarkFleetOnce.Do(func() {
+		rng := rand.New(rand.NewSource(1))
+		benchmarkFleet = store.NewSortedSet()
+		for i := 0; i < benchmarkFleetSize; i++ {
+			lat, lon := 48+rng.Float64()*4.5, 2+rng.Float64()*6.5
+			benchmarkFleet.Add(float64(geohash.Encode(lat, lon)), "vehicle"+strconv.Itoa(i))
+		}
+	})
+	return benchmarkFleet
+}
+
+func BenchmarkGeoSearchIndexed(b *testing.B) {
+	store.Set("fleet", &store.Value{SortedSetData: fleet()})
+	defer store.Delete("fleet")
+	cli := setupGeohashTestClient()
+	args := []string{"fleet", "FROMLONLAT", "5.2", "50.1", "BYRADIUS", "2", "km"}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		command.New("geosearch", args).Execute(cli)
+	}
+}
+
+func BenchmarkGeoSearchBruteForce(b *testing.B) {
+	zset := fleet()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		bruteForceGeoSearch(zset, 50.1, 5.2, 2000, 0)
+	}
+}
+
+func TestGeoHashCommand(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		expected string
+	}{
+		{
+			name:     "Standard geohash strings",
+			args:     []string{"sicily", "palermo", "catania"},
+			expected: "*2\r\n$11\r\nsqc8b49rny0\r\n$11\r\nsqdtr74hyu0\r\n",
+		},
+		{
+			name:     "Missing member",
+			args:     []string{"sicily", "palermo", "nobody"},
+			expected: "*2\r\n$11\r\nsqc8b49rny0\r\n$-1\r\n",
+		},
+		{
+			name:     "Missing key",
+			args:     []string{"missing", "palermo"},
+			expected: "*1\r\n$-1\r\n",
+		},
+		{
+			name:     "Error on wrong number of arguments",
+			args:     []string{"sicily"},
+			expected: "-wrong number of arguments\r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store.Delete("sicily")
+			cli := setupGeohashTestClient()
+			command.New("geoadd", []string{"sicily", "13.361389", "38.115556", "palermo", "15.087269", "37.502669", "catania"}).Execute(cli)
+
+			result := command.New("geohash", tt.args).Execute(cli)
+			if string(result) != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, string(result))
+			}
+		})
+	}
+}
+
+func TestBase32RoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		lat, lon := rng.Float64()*180-90, rng.Float64()*360-180
+
+		hash := geohash.EncodeBase32(lat, lon)
+		decodedLat, decodedLon, err := geohash.DecodeBase32(hash)
+		if err != nil {
+			t.Fatalf("DecodeBase32(%q): %v", hash, err)
+		}
+
+		// Ten full characters locate the point to within about a meter
+		if d := geohash.Distance(lat, lon, decodedLat, decodedLon); d > 2 {
+			t.Fatalf("%q decodes %.2fm away from (%f, %f)", hash, d, lat, lon)
+		}
+		if again := geohash.EncodeBase32(decodedLat, decodedLon); again[:10] != hash[:10] {
+			t.Fatalf("re-encoding %q gives %q", hash, again)
+		}
+	}
+}
+
+func TestDecodeBase32(t *testing.T) {
+	lat, lon, err := geohash.DecodeBase32("u4pruydqqvj")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if geohash.Distance(lat, lon, 57.64911, 10.40744) > 1 {
+		t.Errorf("Expected a point near (57.64911, 10.40744), got (%f, %f)", lat, lon)
+	}
+
+	if _, _, err := geohash.DecodeBase32("u4pa"); err != geohash.ErrInvalidBase32 {
+		t.Errorf("Expected ErrInvalidBase32 for a string outside the alphabet, got %v", err)
+	}
+}
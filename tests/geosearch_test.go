@@ -0,0 +1,207 @@
+package tests
+
+import (
+	"net"
+	"testing"
+
+	command "github.com/SuchintK/GoDisKV/commands"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+func setupGeoSearchTestClient() *client.Client {
+	conn1, _ := net.Pipe()
+	cli := client.New(conn1)
+	return &cli
+}
+
+// TestGeoSearchCommands runs each command against sicily holding palermo, catania, edge1 and edge2
+func TestGeoSearchCommands(t *testing.T) {
+	tests := []struct {
+		name     string
+		command  string
+		args     []string
+		expected string
+	}{
+		{
+			name:     "Search by radius from coordinates",
+			command:  "geosearch",
+			args:     []string{"sicily", "FROMLONLAT", "15", "37", "BYRADIUS", "200", "km", "ASC"},
+			expected: "*2\r\n$7\r\ncatania\r\n$7\r\npalermo\r\n",
+		},
+		{
+			name:     "Search by box reaches the corners",
+			command:  "geosearch",
+			args:     []string{"sicily", "FROMLONLAT", "15", "37", "BYBOX", "400", "400", "km", "ASC", "WITHDIST"},
+			expected: "*4\r\n*2\r\n$7\r\ncatania\r\n$13\r\n56.4410857363\r\n*2\r\n$7\r\npalermo\r\n$14\r\n190.4425183478\r\n*2\r\n$5\r\nedge2\r\n$14\r\n279.7400772498\r\n*2\r\n$5\r\nedge1\r\n$14\r\n279.7405186553\r\n",
+		},
+		{
+			name:     "Short box excludes members further north",
+			command:  "geosearch",
+			args:     []string{"sicily", "FROMLONLAT", "15", "37", "BYBOX", "400", "200", "km", "ASC"},
+			expected: "*1\r\n$7\r\ncatania\r\n",
+		},
+		{
+			name:     "Search from a member in descending order",
+			command:  "geosearch",
+			args:     []string{"sicily", "FROMMEMBER", "palermo", "BYRADIUS", "200", "km", "DESC", "WITHHASH"},
+			expected: "*3\r\n*2\r\n$7\r\ncatania\r\n:3479447370796909\r\n*2\r\n$5\r\nedge1\r\n:3479273021651468\r\n*2\r\n$7\r\npalermo\r\n:3479099956230698\r\n",
+		},
+		{
+			name:     "COUNT returns the closest matches",
+			command:  "geosearch",
+			args:     []string{"sicily", "FROMLONLAT", "15", "37", "BYBOX", "400", "400", "km", "COUNT", "1"},
+			expected: "*1\r\n$7\r\ncatania\r\n",
+		},
+		{
+			name:     "Search on missing key",
+			command:  "geosearch",
+			args:     []string{"missing", "FROMLONLAT", "15", "37", "BYRADIUS", "200", "km"},
+			expected: "*0\r\n",
+		},
+		{
+			name:     "GEORADIUSBYMEMBER",
+			command:  "georadiusbymember",
+			args:     []string{"sicily", "palermo", "100", "km", "ASC"},
+			expected: "*2\r\n$7\r\npalermo\r\n$5\r\nedge1\r\n",
+		},
+		{
+			name:     "Error on missing center",
+			command:  "geosearch",
+			args:     []string{"sicily", "BYRADIUS", "200", "km"},
+			expected: "-ERR exactly one of FROMMEMBER or FROMLONLAT can be specified for GEOSEARCH\r\n",
+		},
+		{
+			name:     "Error on two shapes",
+			command:  "geosearch",
+			args:     []string{"sicily", "FROMMEMBER", "palermo", "BYRADIUS", "200", "km", "BYBOX", "1", "1", "km"},
+			expected: "-ERR exactly one of BYRADIUS and BYBOX can be specified for GEOSEARCH\r\n",
+		},
+		{
+			name:     "Error on ANY outside COUNT",
+			command:  "geosearch",
+			args:     []string{"sicily", "FROMMEMBER", "palermo", "BYRADIUS", "200", "km", "ANY"},
+			expected: "-syntax error\r\n",
+		},
+		{
+			name:     "Error on unknown center member",
+			command:  "geosearch",
+			args:     []string{"sicily", "FROMMEMBER", "nobody", "BYRADIUS", "200", "km"},
+			expected: "-ERR could not decode requested zset member\r\n",
+		},
+		{
+			name:     "Error on an infinite radius",
+			command:  "geosearch",
+			args:     []string{"sicily", "FROMMEMBER", "palermo", "BYRADIUS", "inf", "km"},
+			expected: "-ERR need numeric radius\r\n",
+		},
+		{
+			name:     "Error on a NaN radius",
+			command:  "georadius",
+			args:     []string{"sicily", "15", "37", "nan", "km"},
+			expected: "-ERR need numeric radius\r\n",
+		},
+		{
+			name:     "Error on a negative box height",
+			command:  "geosearch",
+			args:     []string{"sicily", "FROMMEMBER", "palermo", "BYBOX", "1", "-1", "km"},
+			expected: "-ERR need numeric height\r\n",
+		},
+		{
+			name:     "Error on STORE with WITHDIST",
+			command:  "georadius",
+			args:     []string{"sicily", "15", "37", "200", "km", "WITHDIST", "STORE", "nearby"},
+			expected: "-ERR STORE option in GEORADIUS is not compatible with WITHDIST, WITHHASH and WITHCOORDS options\r\n",
+		},
+		{
+			name:     "GEOSEARCHSTORE rejects WITH options",
+			command:  "geosearchstore",
+			args:     []string{"nearby", "sicily", "FROMMEMBER", "palermo", "BYRADIUS", "200", "km", "WITHDIST"},
+			expected: "-syntax error\r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store.Delete("sicily")
+			cli := setupGeoSearchTestClient()
+			command.New("geoadd", []string{"sicily",
+				"13.361389", "38.115556", "palermo",
+				"15.087269", "37.502669", "catania",
+				"12.758489", "38.788135", "edge1",
+				"17.241510", "38.788135", "edge2",
+			}).Execute(cli)
+
+			result := command.New(tt.command, tt.args).Execute(cli)
+			if string(result) != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, string(result))
+			}
+		})
+	}
+}
+
+func TestGeoSearchStore(t *testing.T) {
+	tests := []struct {
+		name     string
+		command  string
+		args     []string
+		expected string
+		stored   string // ZRANGE nearby 0 -1 WITHSCORES after the command
+	}{
+		{
+			name:     "Store members scored by geohash",
+			command:  "geosearchstore",
+			args:     []string{"nearby", "sicily", "FROMLONLAT", "15", "37", "BYRADIUS", "200", "km"},
+			expected: ":2\r\n",
+			stored:   "*4\r\n$7\r\npalermo\r\n$16\r\n3479099956230698\r\n$7\r\ncatania\r\n$16\r\n3479447370796909\r\n",
+		},
+		{
+			name:     "Store members scored by distance",
+			command:  "geosearchstore",
+			args:     []string{"nearby", "sicily", "FROMLONLAT", "15", "37", "BYRADIUS", "200", "km", "STOREDIST"},
+			expected: ":2\r\n",
+			stored:   "*4\r\n$7\r\ncatania\r\n$18\r\n56.441085736273514\r\n$7\r\npalermo\r\n$17\r\n190.4425183477888\r\n",
+		},
+		{
+			name:     "GEORADIUS STORE",
+			command:  "georadius",
+			args:     []string{"sicily", "15", "37", "100", "km", "STORE", "nearby"},
+			expected: ":1\r\n",
+			stored:   "*2\r\n$7\r\ncatania\r\n$16\r\n3479447370796909\r\n",
+		},
+		{
+			name:     "GEORADIUSBYMEMBER STOREDIST",
+			command:  "georadiusbymember",
+			args:     []string{"sicily", "palermo", "10", "km", "STOREDIST", "nearby"},
+			expected: ":1\r\n",
+			stored:   "*2\r\n$7\r\npalermo\r\n$1\r\n0\r\n",
+		},
+		{
+			name:     "Empty result removes the destination",
+			command:  "geosearchstore",
+			args:     []string{"nearby", "sicily", "FROMLONLAT", "0", "0", "BYRADIUS", "1", "km"},
+			expected: ":0\r\n",
+			stored:   "*0\r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store.Delete("sicily")
+			store.Delete("nearby")
+			cli := setupGeoSearchTestClient()
+			command.New("geoadd", []string{"sicily", "13.361389", "38.115556", "palermo", "15.087269", "37.502669", "catania"}).Execute(cli)
+			command.New("zadd", []string{"nearby", "1", "stale"}).Execute(cli)
+
+			result := command.New(tt.command, tt.args).Execute(cli)
+			if string(result) != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, string(result))
+			}
+
+			stored := command.New("zrange", []string{"nearby", "0", "-1", "WITHSCORES"}).Execute(cli)
+			if string(stored) != tt.stored {
+				t.Errorf("Expected stored %q, got %q", tt.stored, string(stored))
+			}
+		})
+	}
+}
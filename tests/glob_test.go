@@ -0,0 +1,36 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/SuchintK/GoDisKV/glob"
+)
+
+func TestGlobMatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		pattern  string
+		s        string
+		expected bool
+	}{
+		{"Star matches everything", "*", "anything", true},
+		{"Star in the middle", "h*llo", "heeello", true},
+		{"Backtrack to the last star", "*ab*c", "aabxabyc", true},
+		{"Trailing literal must match", "*ab", "abba", false},
+		{"Question mark matches one character", "h?llo", "hallo", true},
+		{"Character class", "h[ae]llo", "hello", true},
+		{"Negated class", "h[^e]llo", "hello", false},
+		{"Escaped star is literal", "a\\*", "a*", true},
+		{"Escaped star does not match others", "a\\*", "ab", false},
+		{"Many stars against a long subject", strings.Repeat("*a", 50) + "b", strings.Repeat("a", 10000), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := glob.Match(tt.pattern, tt.s); got != tt.expected {
+				t.Errorf("Match(%q, %q) = %v, expected %v", tt.pattern, tt.s, got, tt.expected)
+			}
+		})
+	}
+}
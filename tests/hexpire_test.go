@@ -0,0 +1,273 @@
+package tests
+
+import (
+	"net"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	command "github.com/SuchintK/GoDisKV/commands"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+func setupHExpireTestClient() *client.Client {
+	conn1, _ := net.Pipe()
+	cli := client.New(conn1)
+	return &cli
+}
+
+func TestHExpireCommand(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func()
+		command  string
+		args     []string
+		expected string
+	}{
+		{
+			name: "Set TTL on existing and missing fields",
+			setup: func() {
+				store.Delete("myhash")
+				cli := setupHExpireTestClient()
+				command.New("hset", []string{"myhash", "f1", "v1"}).Execute(cli)
+			},
+			command:  "hexpire",
+			args:     []string{"myhash", "100", "FIELDS", "2", "f1", "missing"},
+			expected: "*2\r\n:1\r\n:-2\r\n",
+		},
+		{
+			name: "NX does not overwrite an existing TTL",
+			setup: func() {
+				store.Delete("myhash")
+				cli := setupHExpireTestClient()
+				command.New("hset", []string{"myhash", "f1", "v1", "f2", "v2"}).Execute(cli)
+				command.New("hexpire", []string{"myhash", "100", "FIELDS", "1", "f1"}).Execute(cli)
+			},
+			command:  "hexpire",
+			args:     []string{"myhash", "200", "NX", "FIELDS", "2", "f1", "f2"},
+			expected: "*2\r\n:0\r\n:1\r\n",
+		},
+		{
+			name: "GT fails on field without TTL",
+			setup: func() {
+				store.Delete("myhash")
+				cli := setupHExpireTestClient()
+				command.New("hset", []string{"myhash", "f1", "v1"}).Execute(cli)
+			},
+			command:  "hexpire",
+			args:     []string{"myhash", "100", "GT", "FIELDS", "1", "f1"},
+			expected: "*1\r\n:0\r\n",
+		},
+		{
+			name: "Zero TTL deletes the field",
+			setup: func() {
+				store.Delete("myhash")
+				cli := setupHExpireTestClient()
+				command.New("hset", []string{"myhash", "f1", "v1", "f2", "v2"}).Execute(cli)
+			},
+			command:  "hpexpire",
+			args:     []string{"myhash", "0", "FIELDS", "1", "f1"},
+			expected: "*1\r\n:2\r\n",
+		},
+		{
+			name: "Past unix time deletes the field",
+			setup: func() {
+				store.Delete("myhash")
+				cli := setupHExpireTestClient()
+				command.New("hset", []string{"myhash", "f1", "v1", "f2", "v2"}).Execute(cli)
+			},
+			command:  "hexpireat",
+			args:     []string{"myhash", "1", "FIELDS", "1", "f1"},
+			expected: "*1\r\n:2\r\n",
+		},
+		{
+			name: "HTTL reports no TTL and missing fields",
+			setup: func() {
+				store.Delete("myhash")
+				cli := setupHExpireTestClient()
+				command.New("hset", []string{"myhash", "f1", "v1", "f2", "v2"}).Execute(cli)
+				command.New("hexpire", []string{"myhash", "100", "FIELDS", "1", "f1"}).Execute(cli)
+			},
+			command:  "httl",
+			args:     []string{"myhash", "FIELDS", "3", "f1", "f2", "missing"},
+			expected: "*3\r\n:100\r\n:-1\r\n:-2\r\n",
+		},
+		{
+			name: "HPERSIST removes TTL",
+			setup: func() {
+				store.Delete("myhash")
+				cli := setupHExpireTestClient()
+				command.New("hset", []string{"myhash", "f1", "v1", "f2", "v2"}).Execute(cli)
+				command.New("hexpire", []string{"myhash", "100", "FIELDS", "1", "f1"}).Execute(cli)
+			},
+			command:  "hpersist",
+			args:     []string{"myhash", "FIELDS", "2", "f1", "f2"},
+			expected: "*2\r\n:1\r\n:-1\r\n",
+		},
+		{
+			name: "Missing key reports every field as missing",
+			setup: func() {
+				store.Delete("myhash")
+			},
+			command:  "hpttl",
+			args:     []string{"myhash", "FIELDS", "2", "f1", "f2"},
+			expected: "*2\r\n:-2\r\n:-2\r\n",
+		},
+		{
+			name: "Error on missing FIELDS keyword",
+			setup: func() {
+			},
+			command:  "hexpire",
+			args:     []string{"myhash", "100", "2", "f1", "f2"},
+			expected: "-ERR Mandatory argument FIELDS is missing or not at the right position\r\n",
+		},
+		{
+			name: "Error on numfields mismatch",
+			setup: func() {
+			},
+			command:  "hexpire",
+			args:     []string{"myhash", "100", "FIELDS", "3", "f1", "f2"},
+			expected: "-ERR The `numfields` parameter must match the number of arguments\r\n",
+		},
+		{
+			name: "Error on wrong type",
+			setup: func() {
+				store.Delete("mystring")
+				cli := setupHExpireTestClient()
+				command.New("set", []string{"mystring", "value"}).Execute(cli)
+			},
+			command:  "hexpire",
+			args:     []string{"mystring", "100", "FIELDS", "1", "f1"},
+			expected: "-WRONGTYPE Operation against a key holding the wrong kind of value\r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			cli := setupHExpireTestClient()
+			cmd := command.New(tt.command, tt.args)
+			result := cmd.Execute(cli)
+
+			if string(result) != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, string(result))
+			}
+		})
+	}
+}
+
+func TestHashFieldLazyExpiration(t *testing.T) {
+	store.Delete("myhash")
+	cli := setupHExpireTestClient()
+	command.New("hset", []string{"myhash", "f1", "v1", "f2", "v2"}).Execute(cli)
+	command.New("hpexpire", []string{"myhash", "20", "FIELDS", "1", "f1"}).Execute(cli)
+
+	time.Sleep(50 * time.Millisecond)
+
+	result := command.New("hget", []string{"myhash", "f1"}).Execute(cli)
+	if string(result) != "$-1\r\n" {
+		t.Errorf("Expected expired field to be gone, got %q", string(result))
+	}
+	result = command.New("hlen", []string{"myhash"}).Execute(cli)
+	if string(result) != ":1\r\n" {
+		t.Errorf("Expected 1 remaining field, got %q", string(result))
+	}
+}
+
+func TestHashKeyRemovedWhenLastFieldExpires(t *testing.T) {
+	store.Delete("myhash")
+	cli := setupHExpireTestClient()
+	command.New("hset", []string{"myhash", "f1", "v1"}).Execute(cli)
+	command.New("hpexpire", []string{"myhash", "20", "FIELDS", "1", "f1"}).Execute(cli)
+
+	time.Sleep(50 * time.Millisecond)
+
+	if _, exists := store.Get("myhash"); exists {
+		t.Error("Expected key to be removed once its last field expired")
+	}
+}
+
+func TestHSetClearsFieldTTL(t *testing.T) {
+	store.Delete("myhash")
+	cli := setupHExpireTestClient()
+	command.New("hset", []string{"myhash", "f1", "v1"}).Execute(cli)
+	command.New("hexpire", []string{"myhash", "100", "FIELDS", "1", "f1"}).Execute(cli)
+	command.New("hset", []string{"myhash", "f1", "v2"}).Execute(cli)
+
+	result := command.New("httl", []string{"myhash", "FIELDS", "1", "f1"}).Execute(cli)
+	if string(result) != "*1\r\n:-1\r\n" {
+		t.Errorf("Expected TTL to be cleared by HSET, got %q", string(result))
+	}
+}
+
+func TestHIncrByKeepsFieldTTL(t *testing.T) {
+	store.Delete("myhash")
+	cli := setupHExpireTestClient()
+	command.New("hset", []string{"myhash", "n", "1", "f", "1.5"}).Execute(cli)
+	command.New("hexpire", []string{"myhash", "100", "FIELDS", "2", "n", "f"}).Execute(cli)
+	command.New("hincrby", []string{"myhash", "n", "2"}).Execute(cli)
+	command.New("hincrbyfloat", []string{"myhash", "f", "0.5"}).Execute(cli)
+
+	result := command.New("httl", []string{"myhash", "FIELDS", "2", "n", "f"}).Execute(cli)
+	if string(result) != "*2\r\n:100\r\n:100\r\n" {
+		t.Errorf("Expected TTLs to survive HINCRBY and HINCRBYFLOAT, got %q", string(result))
+	}
+	result = command.New("hmget", []string{"myhash", "n", "f"}).Execute(cli)
+	if string(result) != "*2\r\n$1\r\n3\r\n$1\r\n2\r\n" {
+		t.Errorf("Expected incremented values, got %q", string(result))
+	}
+}
+
+func TestHExpireReplicatesAbsoluteTime(t *testing.T) {
+	store.Delete("myhash")
+	cli := setupHExpireTestClient()
+	command.New("hset", []string{"myhash", "f1", "v1"}).Execute(cli)
+
+	before := time.Now().Add(5 * time.Second).UnixMilli()
+	command.New("hpexpire", []string{"myhash", "5000", "NX", "FIELDS", "1", "f1"}).Execute(cli)
+	after := time.Now().Add(5 * time.Second).UnixMilli()
+
+	propagated := cli.TakePropagated()
+	if len(propagated) != 1 || len(propagated[0]) != 7 {
+		t.Fatalf("Expected a single HPEXPIREAT to be replicated, got %v", propagated)
+	}
+	args := propagated[0]
+	at, err := strconv.ParseInt(args[2], 10, 64)
+	if err != nil || at < before || at > after {
+		t.Errorf("Expected deadline between %d and %d, got %s", before, after, args[2])
+	}
+	expected := []string{"hpexpireat", "myhash", args[2], "NX", "FIELDS", "1", "f1"}
+	if !reflect.DeepEqual(args, expected) {
+		t.Errorf("Expected %v to be replicated, got %v", expected, args)
+	}
+}
+
+func TestActiveExpirationReportsRemovedFields(t *testing.T) {
+	store.Delete("myhash")
+	expired := make(chan []string, 1)
+	store.StartActiveExpiration(func(key string, fields []string) {
+		if key != "myhash" {
+			return
+		}
+		// Never block the cycle, it holds the database lock
+		select {
+		case expired <- fields:
+		default:
+		}
+	})
+
+	cli := setupHExpireTestClient()
+	command.New("hset", []string{"myhash", "f2", "v", "f1", "v", "keep", "v"}).Execute(cli)
+	command.New("hpexpire", []string{"myhash", "50", "FIELDS", "2", "f1", "f2"}).Execute(cli)
+
+	select {
+	case fields := <-expired:
+		if !reflect.DeepEqual(fields, []string{"f1", "f2"}) {
+			t.Errorf("Expected [f1 f2] to be reported, got %v", fields)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected expired fields to be reported by the active expiration cycle")
+	}
+}
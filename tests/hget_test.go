@@ -0,0 +1,163 @@
+package tests
+
+import (
+	"net"
+	"testing"
+
+	command "github.com/SuchintK/GoDisKV/commands"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+func setupHGetTestClient() *client.Client {
+	conn1, _ := net.Pipe()
+	cli := client.New(conn1)
+	return &cli
+}
+
+func TestHashReadCommands(t *testing.T) {
+	setup := func() {
+		store.Delete("myhash")
+		cli := setupHGetTestClient()
+		command.New("hset", []string{"myhash", "name", "alice", "age", "30"}).Execute(cli)
+	}
+
+	tests := []struct {
+		name     string
+		command  string
+		args     []string
+		expected string
+	}{
+		{
+			name:     "HGET existing field",
+			command:  "hget",
+			args:     []string{"myhash", "name"},
+			expected: "$5\r\nalice\r\n",
+		},
+		{
+			name:     "HGET missing field",
+			command:  "hget",
+			args:     []string{"myhash", "missing"},
+			expected: "$-1\r\n",
+		},
+		{
+			name:     "HGET missing key",
+			command:  "hget",
+			args:     []string{"nohash", "name"},
+			expected: "$-1\r\n",
+		},
+		{
+			name:     "HMGET mixes values and nils",
+			command:  "hmget",
+			args:     []string{"myhash", "name", "missing", "age"},
+			expected: "*3\r\n$5\r\nalice\r\n$-1\r\n$2\r\n30\r\n",
+		},
+		{
+			name:     "HGETALL returns fields in order",
+			command:  "hgetall",
+			args:     []string{"myhash"},
+			expected: "*4\r\n$3\r\nage\r\n$2\r\n30\r\n$4\r\nname\r\n$5\r\nalice\r\n",
+		},
+		{
+			name:     "HGETALL on missing key",
+			command:  "hgetall",
+			args:     []string{"nohash"},
+			expected: "*0\r\n",
+		},
+		{
+			name:     "HKEYS",
+			command:  "hkeys",
+			args:     []string{"myhash"},
+			expected: "*2\r\n$3\r\nage\r\n$4\r\nname\r\n",
+		},
+		{
+			name:     "HVALS",
+			command:  "hvals",
+			args:     []string{"myhash"},
+			expected: "*2\r\n$2\r\n30\r\n$5\r\nalice\r\n",
+		},
+		{
+			name:     "HLEN",
+			command:  "hlen",
+			args:     []string{"myhash"},
+			expected: ":2\r\n",
+		},
+		{
+			name:     "HEXISTS existing field",
+			command:  "hexists",
+			args:     []string{"myhash", "age"},
+			expected: ":1\r\n",
+		},
+		{
+			name:     "HEXISTS missing field",
+			command:  "hexists",
+			args:     []string{"myhash", "missing"},
+			expected: ":0\r\n",
+		},
+		{
+			name:     "HSTRLEN",
+			command:  "hstrlen",
+			args:     []string{"myhash", "name"},
+			expected: ":5\r\n",
+		},
+		{
+			name:     "HRANDFIELD positive count is capped at hash size",
+			command:  "hrandfield",
+			args:     []string{"myhash", "5"},
+			expected: "",
+		},
+		{
+			name:     "HRANDFIELD on missing key",
+			command:  "hrandfield",
+			args:     []string{"nohash"},
+			expected: "$-1\r\n",
+		},
+		{
+			name:     "HRANDFIELD rejects a count out of range",
+			command:  "hrandfield",
+			args:     []string{"myhash", "-9223372036854775808"},
+			expected: "-ERR value is out of range\r\n",
+		},
+		{
+			name:     "Error on wrong number of arguments",
+			command:  "hget",
+			args:     []string{"myhash"},
+			expected: "-wrong number of arguments\r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setup()
+			cli := setupHGetTestClient()
+			cmd := command.New(tt.command, tt.args)
+			result := cmd.Execute(cli)
+
+			// Random replies are only checked for their length
+			if tt.command == "hrandfield" && tt.expected == "" {
+				if string(result[:4]) != "*2\r\n" {
+					t.Errorf("Expected 2 random fields, got %q", string(result))
+				}
+				return
+			}
+
+			if string(result) != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, string(result))
+			}
+		})
+	}
+}
+
+func TestHashReadCommandsWrongType(t *testing.T) {
+	store.Delete("mystring")
+	cli := setupHGetTestClient()
+	command.New("set", []string{"mystring", "value"}).Execute(cli)
+
+	for _, label := range []string{"hget", "hmget", "hexists", "hstrlen"} {
+		result := command.New(label, []string{"mystring", "field"}).Execute(cli)
+		expected := "-WRONGTYPE Operation against a key holding the wrong kind of value\r\n"
+		if string(result) != expected {
+			t.Errorf("%s: expected %q, got %q", label, expected, string(result))
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package tests
+
+import (
+	"net"
+	"testing"
+
+	command "github.com/SuchintK/GoDisKV/commands"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+func setupHIncrByTestClient() *client.Client {
+	conn1, _ := net.Pipe()
+	cli := client.New(conn1)
+	return &cli
+}
+
+func TestHIncrByCommand(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func()
+		command  string
+		args     []string
+		expected string
+	}{
+		{
+			name: "Increment missing field",
+			setup: func() {
+				store.Delete("myhash")
+			},
+			command:  "hincrby",
+			args:     []string{"myhash", "counter", "5"},
+			expected: ":5\r\n",
+		},
+		{
+			name: "Decrement existing field",
+			setup: func() {
+				store.Delete("myhash")
+				cli := setupHIncrByTestClient()
+				command.New("hset", []string{"myhash", "counter", "10"}).Execute(cli)
+			},
+			command:  "hincrby",
+			args:     []string{"myhash", "counter", "-3"},
+			expected: ":7\r\n",
+		},
+		{
+			name: "Error on non-integer field",
+			setup: func() {
+				store.Delete("myhash")
+				cli := setupHIncrByTestClient()
+				command.New("hset", []string{"myhash", "counter", "abc"}).Execute(cli)
+			},
+			command:  "hincrby",
+			args:     []string{"myhash", "counter", "1"},
+			expected: "-ERR hash value is not an integer\r\n",
+		},
+		{
+			name: "Error on overflow",
+			setup: func() {
+				store.Delete("myhash")
+				cli := setupHIncrByTestClient()
+				command.New("hset", []string{"myhash", "counter", "9223372036854775807"}).Execute(cli)
+			},
+			command:  "hincrby",
+			args:     []string{"myhash", "counter", "1"},
+			expected: "-ERR increment or decrement would overflow\r\n",
+		},
+		{
+			name: "Error on invalid increment",
+			setup: func() {
+				store.Delete("myhash")
+			},
+			command:  "hincrby",
+			args:     []string{"myhash", "counter", "1.5"},
+			expected: "-ERR value is not an integer or out of range\r\n",
+		},
+		{
+			name: "Float increment on missing field",
+			setup: func() {
+				store.Delete("myhash")
+			},
+			command:  "hincrbyfloat",
+			args:     []string{"myhash", "price", "10.5"},
+			expected: "$4\r\n10.5\r\n",
+		},
+		{
+			name: "Float increment on integer field",
+			setup: func() {
+				store.Delete("myhash")
+				cli := setupHIncrByTestClient()
+				command.New("hset", []string{"myhash", "price", "3"}).Execute(cli)
+			},
+			command:  "hincrbyfloat",
+			args:     []string{"myhash", "price", "-0.25"},
+			expected: "$4\r\n2.75\r\n",
+		},
+		{
+			name: "Error on non-float field",
+			setup: func() {
+				store.Delete("myhash")
+				cli := setupHIncrByTestClient()
+				command.New("hset", []string{"myhash", "price", "abc"}).Execute(cli)
+			},
+			command:  "hincrbyfloat",
+			args:     []string{"myhash", "price", "1"},
+			expected: "-ERR hash value is not a float\r\n",
+		},
+		{
+			name: "Error on wrong type",
+			setup: func() {
+				store.Delete("mystring")
+				cli := setupHIncrByTestClient()
+				command.New("set", []string{"mystring", "value"}).Execute(cli)
+			},
+			command:  "hincrby",
+			args:     []string{"mystring", "counter", "1"},
+			expected: "-WRONGTYPE Operation against a key holding the wrong kind of value\r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			cli := setupHIncrByTestClient()
+			cmd := command.New(tt.command, tt.args)
+			result := cmd.Execute(cli)
+
+			if string(result) != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, string(result))
+			}
+		})
+	}
+}
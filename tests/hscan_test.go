@@ -0,0 +1,108 @@
+package tests
+
+import (
+	"net"
+	"testing"
+
+	command "github.com/SuchintK/GoDisKV/commands"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+func setupHScanTestClient() *client.Client {
+	conn1, _ := net.Pipe()
+	cli := client.New(conn1)
+	return &cli
+}
+
+func TestHScanCommand(t *testing.T) {
+	setup := func() {
+		store.Delete("myhash")
+		cli := setupHScanTestClient()
+		command.New("hset", []string{"myhash", "a1", "1", "a2", "2", "b1", "3"}).Execute(cli)
+	}
+
+	tests := []struct {
+		name     string
+		args     []string
+		expected string
+	}{
+		{
+			name:     "Scan whole hash in one call",
+			args:     []string{"myhash", "0"},
+			expected: "*2\r\n$1\r\n0\r\n*6\r\n$2\r\na1\r\n$1\r\n1\r\n$2\r\na2\r\n$1\r\n2\r\n$2\r\nb1\r\n$1\r\n3\r\n",
+		},
+		{
+			name:     "Scan with COUNT returns next cursor",
+			args:     []string{"myhash", "0", "COUNT", "2"},
+			expected: "*2\r\n$1\r\n2\r\n*4\r\n$2\r\na1\r\n$1\r\n1\r\n$2\r\na2\r\n$1\r\n2\r\n",
+		},
+		{
+			name:     "Scan continues from cursor",
+			args:     []string{"myhash", "2", "COUNT", "2"},
+			expected: "*2\r\n$1\r\n0\r\n*2\r\n$2\r\nb1\r\n$1\r\n3\r\n",
+		},
+		{
+			name:     "Scan with MATCH and NOVALUES",
+			args:     []string{"myhash", "0", "MATCH", "a*", "NOVALUES"},
+			expected: "*2\r\n$1\r\n0\r\n*2\r\n$2\r\na1\r\n$2\r\na2\r\n",
+		},
+		{
+			name:     "Scan with a COUNT larger than the hash",
+			args:     []string{"myhash", "1", "COUNT", "9223372036854775807", "NOVALUES"},
+			expected: "*2\r\n$1\r\n0\r\n*2\r\n$2\r\na2\r\n$2\r\nb1\r\n",
+		},
+		{
+			name:     "Scan missing key",
+			args:     []string{"nohash", "0"},
+			expected: "*2\r\n$1\r\n0\r\n*0\r\n",
+		},
+		{
+			name:     "Error on invalid cursor",
+			args:     []string{"myhash", "abc"},
+			expected: "-ERR invalid cursor\r\n",
+		},
+		{
+			name:     "Error on unknown option",
+			args:     []string{"myhash", "0", "FOO"},
+			expected: "-syntax error\r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setup()
+			cli := setupHScanTestClient()
+			cmd := command.New("hscan", tt.args)
+			result := cmd.Execute(cli)
+
+			if string(result) != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, string(result))
+			}
+		})
+	}
+}
+
+func TestHScanCursorSurvivesDeletes(t *testing.T) {
+	store.Delete("myhash")
+	cli := setupHScanTestClient()
+	command.New("hset", []string{"myhash", "a1", "1", "a2", "2", "b1", "3"}).Execute(cli)
+
+	steps := []struct {
+		command  string
+		args     []string
+		expected string
+	}{
+		{"hscan", []string{"myhash", "0", "COUNT", "2", "NOVALUES"}, "*2\r\n$1\r\n2\r\n*2\r\n$2\r\na1\r\n$2\r\na2\r\n"},
+		{"hdel", []string{"myhash", "a1", "a2"}, ":2\r\n"},
+		{"hset", []string{"myhash", "a0", "0"}, ":1\r\n"},
+		{"hscan", []string{"myhash", "2", "NOVALUES"}, "*2\r\n$1\r\n0\r\n*2\r\n$2\r\nb1\r\n$2\r\na0\r\n"},
+	}
+
+	for _, step := range steps {
+		result := command.New(step.command, step.args).Execute(cli)
+		if string(result) != step.expected {
+			t.Errorf("%s %v: expected %q, got %q", step.command, step.args, step.expected, string(result))
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package tests
+
+import (
+	"net"
+	"testing"
+
+	command "github.com/SuchintK/GoDisKV/commands"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+func setupHSetTestClient() *client.Client {
+	conn1, _ := net.Pipe()
+	cli := client.New(conn1)
+	return &cli
+}
+
+func TestHSetCommand(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func()
+		command  string
+		args     []string
+		expected string
+		validate func(t *testing.T)
+	}{
+		{
+			name: "Set fields on new hash",
+			setup: func() {
+				store.Delete("myhash")
+			},
+			command:  "hset",
+			args:     []string{"myhash", "f1", "v1", "f2", "v2"},
+			expected: ":2\r\n",
+			validate: func(t *testing.T) {
+				val, exists := store.Get("myhash")
+				if !exists || val.HashData == nil {
+					t.Fatal("Expected hash to be created")
+				}
+				if val.HashData.Len() != 2 {
+					t.Errorf("Expected 2 fields, got %d", val.HashData.Len())
+				}
+				value, _ := val.HashData.Get("f2")
+				if value != "v2" {
+					t.Errorf("Expected f2 = v2, got %q", value)
+				}
+			},
+		},
+		{
+			name: "Overwrite existing field counts only new fields",
+			setup: func() {
+				store.Delete("myhash")
+				cli := setupHSetTestClient()
+				command.New("hset", []string{"myhash", "f1", "v1"}).Execute(cli)
+			},
+			command:  "hset",
+			args:     []string{"myhash", "f1", "new", "f2", "v2"},
+			expected: ":1\r\n",
+			validate: func(t *testing.T) {
+				val, _ := store.Get("myhash")
+				value, _ := val.HashData.Get("f1")
+				if value != "new" {
+					t.Errorf("Expected f1 = new, got %q", value)
+				}
+			},
+		},
+		{
+			name: "HSETNX sets missing field",
+			setup: func() {
+				store.Delete("myhash")
+			},
+			command:  "hsetnx",
+			args:     []string{"myhash", "f1", "v1"},
+			expected: ":1\r\n",
+		},
+		{
+			name: "HSETNX keeps existing field",
+			setup: func() {
+				store.Delete("myhash")
+				cli := setupHSetTestClient()
+				command.New("hset", []string{"myhash", "f1", "v1"}).Execute(cli)
+			},
+			command:  "hsetnx",
+			args:     []string{"myhash", "f1", "v2"},
+			expected: ":0\r\n",
+			validate: func(t *testing.T) {
+				val, _ := store.Get("myhash")
+				value, _ := val.HashData.Get("f1")
+				if value != "v1" {
+					t.Errorf("Expected f1 = v1, got %q", value)
+				}
+			},
+		},
+		{
+			name: "HDEL removes fields",
+			setup: func() {
+				store.Delete("myhash")
+				cli := setupHSetTestClient()
+				command.New("hset", []string{"myhash", "f1", "v1", "f2", "v2"}).Execute(cli)
+			},
+			command:  "hdel",
+			args:     []string{"myhash", "f1", "missing"},
+			expected: ":1\r\n",
+		},
+		{
+			name: "HDEL of last field deletes key",
+			setup: func() {
+				store.Delete("myhash")
+				cli := setupHSetTestClient()
+				command.New("hset", []string{"myhash", "f1", "v1"}).Execute(cli)
+			},
+			command:  "hdel",
+			args:     []string{"myhash", "f1"},
+			expected: ":1\r\n",
+			validate: func(t *testing.T) {
+				if _, exists := store.Get("myhash"); exists {
+					t.Error("Expected key to be deleted after removing last field")
+				}
+			},
+		},
+		{
+			name: "HDEL on missing key",
+			setup: func() {
+				store.Delete("myhash")
+			},
+			command:  "hdel",
+			args:     []string{"myhash", "f1"},
+			expected: ":0\r\n",
+		},
+		{
+			name: "Error on odd number of field-value pairs",
+			setup: func() {
+			},
+			command:  "hset",
+			args:     []string{"myhash", "f1", "v1", "f2"},
+			expected: "-wrong number of arguments\r\n",
+		},
+		{
+			name: "Error on wrong type",
+			setup: func() {
+				store.Delete("mystring")
+				cli := setupHSetTestClient()
+				command.New("set", []string{"mystring", "value"}).Execute(cli)
+			},
+			command:  "hset",
+			args:     []string{"mystring", "f1", "v1"},
+			expected: "-WRONGTYPE Operation against a key holding the wrong kind of value\r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			cli := setupHSetTestClient()
+			cmd := command.New(tt.command, tt.args)
+			result := cmd.Execute(cli)
+
+			if string(result) != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, string(result))
+			}
+
+			if tt.validate != nil {
+				tt.validate(t)
+			}
+		})
+	}
+}
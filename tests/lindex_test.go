@@ -0,0 +1,173 @@
+package tests
+
+import (
+	"net"
+	"testing"
+
+	command "github.com/SuchintK/GoDisKV/commands"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+func setupLIndexTestClient() *client.Client {
+	conn1, _ := net.Pipe()
+	cli := client.New(conn1)
+	return &cli
+}
+
+func TestLIndexAndLSetCommands(t *testing.T) {
+	setup := func() {
+		store.Delete("mylist")
+		cli := setupLIndexTestClient()
+		command.New("rpush", []string{"mylist", "a", "b", "c"}).Execute(cli)
+	}
+
+	tests := []struct {
+		name     string
+		command  string
+		args     []string
+		expected string
+		validate func(t *testing.T)
+	}{
+		{
+			name:     "LINDEX positive index",
+			command:  "lindex",
+			args:     []string{"mylist", "1"},
+			expected: "$1\r\nb\r\n",
+		},
+		{
+			name:     "LINDEX negative index",
+			command:  "lindex",
+			args:     []string{"mylist", "-1"},
+			expected: "$1\r\nc\r\n",
+		},
+		{
+			name:     "LINDEX out of range",
+			command:  "lindex",
+			args:     []string{"mylist", "5"},
+			expected: "$-1\r\n",
+		},
+		{
+			name:     "LSET replaces element",
+			command:  "lset",
+			args:     []string{"mylist", "-2", "x"},
+			expected: "+OK\r\n",
+			validate: func(t *testing.T) {
+				val, _ := store.Get("mylist")
+				if val.ListData.Index(1) != "x" {
+					t.Errorf("Expected element 1 to be x, got %q", val.ListData.Index(1))
+				}
+			},
+		},
+		{
+			name:     "LSET out of range",
+			command:  "lset",
+			args:     []string{"mylist", "3", "x"},
+			expected: "-ERR index out of range\r\n",
+		},
+		{
+			name:     "LSET on missing key",
+			command:  "lset",
+			args:     []string{"nolist", "0", "x"},
+			expected: "-ERR no such key\r\n",
+		},
+		{
+			name:     "LINSERT BEFORE",
+			command:  "linsert",
+			args:     []string{"mylist", "BEFORE", "b", "x"},
+			expected: ":4\r\n",
+			validate: func(t *testing.T) {
+				val, _ := store.Get("mylist")
+				if val.ListData.Index(1) != "x" || val.ListData.Index(2) != "b" {
+					t.Errorf("Expected x before b, got %v", val.ListData.Elements())
+				}
+			},
+		},
+		{
+			name:     "LINSERT AFTER last element",
+			command:  "linsert",
+			args:     []string{"mylist", "AFTER", "c", "x"},
+			expected: ":4\r\n",
+			validate: func(t *testing.T) {
+				val, _ := store.Get("mylist")
+				if val.ListData.Index(3) != "x" {
+					t.Errorf("Expected x at the tail, got %v", val.ListData.Elements())
+				}
+			},
+		},
+		{
+			name:     "LINSERT missing pivot",
+			command:  "linsert",
+			args:     []string{"mylist", "BEFORE", "z", "x"},
+			expected: ":-1\r\n",
+		},
+		{
+			name:     "LINSERT on missing key",
+			command:  "linsert",
+			args:     []string{"nolist", "BEFORE", "a", "x"},
+			expected: ":0\r\n",
+		},
+		{
+			name:     "LPUSHX on existing list",
+			command:  "lpushx",
+			args:     []string{"mylist", "y", "z"},
+			expected: ":5\r\n",
+			validate: func(t *testing.T) {
+				val, _ := store.Get("mylist")
+				if val.ListData.Index(0) != "z" || val.ListData.Index(1) != "y" {
+					t.Errorf("Expected z y at the head, got %v", val.ListData.Elements())
+				}
+			},
+		},
+		{
+			name:     "RPUSHX on missing key does nothing",
+			command:  "rpushx",
+			args:     []string{"nolist", "a"},
+			expected: ":0\r\n",
+			validate: func(t *testing.T) {
+				if _, exists := store.Get("nolist"); exists {
+					t.Error("Expected RPUSHX not to create the key")
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setup()
+			cli := setupLIndexTestClient()
+			cmd := command.New(tt.command, tt.args)
+			result := cmd.Execute(cli)
+
+			if string(result) != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, string(result))
+			}
+
+			if tt.validate != nil {
+				tt.validate(t)
+			}
+		})
+	}
+}
+
+func TestListCommandsWrongType(t *testing.T) {
+	store.Delete("mystring")
+	cli := setupLIndexTestClient()
+	command.New("set", []string{"mystring", "value"}).Execute(cli)
+
+	expected := "-WRONGTYPE Operation against a key holding the wrong kind of value\r\n"
+	for _, args := range [][]string{
+		{"lindex", "mystring", "0"},
+		{"lset", "mystring", "0", "x"},
+		{"linsert", "mystring", "BEFORE", "a", "x"},
+		{"lrem", "mystring", "0", "a"},
+		{"ltrim", "mystring", "0", "1"},
+		{"lpos", "mystring", "a"},
+		{"lpushx", "mystring", "a"},
+	} {
+		result := command.New(args[0], args[1:]).Execute(cli)
+		if string(result) != expected {
+			t.Errorf("%s: expected %q, got %q", args[0], expected, string(result))
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package tests
+
+import (
+	"math/rand"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+// TestListMatchesSliceModel applies random operations to a store.List and a plain slice,
+// checking they stay identical as elements cross node boundaries
+func TestListMatchesSliceModel(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	list := store.NewList()
+	var model []string
+
+	for op := 0; op < 20000; op++ {
+		element := strconv.Itoa(op)
+		switch rng.Intn(8) {
+		case 0, 1:
+			list.PushLeft(element)
+			model = append([]string{element}, model...)
+		case 2, 3:
+			list.PushRight(element)
+			model = append(model, element)
+		case 4:
+			got, ok := list.PopLeft()
+			if ok != (len(model) > 0) || (ok && got != model[0]) {
+				t.Fatalf("op %d: PopLeft returned %q, %v", op, got, ok)
+			}
+			if ok {
+				model = model[1:]
+			}
+		case 5:
+			got, ok := list.PopRight()
+			if ok != (len(model) > 0) || (ok && got != model[len(model)-1]) {
+				t.Fatalf("op %d: PopRight returned %q, %v", op, got, ok)
+			}
+			if ok {
+				model = model[:len(model)-1]
+			}
+		case 6:
+			pos := rng.Intn(len(model) + 1)
+			list.Insert(pos, element)
+			model = append(model[:pos], append([]string{element}, model[pos:]...)...)
+		case 7:
+			if len(model) == 0 {
+				continue
+			}
+			pos := rng.Intn(len(model))
+			list.Set(pos, element)
+			model[pos] = element
+			if got := list.Index(pos); got != element {
+				t.Fatalf("op %d: Index(%d) returned %q, want %q", op, pos, got, element)
+			}
+		}
+
+		if list.Len() != len(model) {
+			t.Fatalf("op %d: Len is %d, want %d", op, list.Len(), len(model))
+		}
+	}
+
+	if !reflect.DeepEqual(list.Elements(), model) {
+		t.Fatal("List elements diverged from the model")
+	}
+	if len(model) > 10 {
+		start, stop := len(model)/3, len(model)-5
+		if !reflect.DeepEqual(list.Range(start, stop), model[start:stop+1]) {
+			t.Error("Range diverged from the model")
+		}
+		list.Trim(start, stop)
+		if !reflect.DeepEqual(list.Elements(), model[start:stop+1]) {
+			t.Error("Trim diverged from the model")
+		}
+	}
+}
+
+func TestListIterateStopsEarly(t *testing.T) {
+	list := store.NewList()
+	for i := 0; i < 1000; i++ {
+		list.PushRight(strconv.Itoa(i))
+	}
+
+	var visited []int
+	list.Iterate(true, func(index int, element string) bool {
+		if element != strconv.Itoa(index) {
+			t.Fatalf("Element %q reported at index %d", element, index)
+		}
+		visited = append(visited, index)
+		return len(visited) < 3
+	})
+	if !reflect.DeepEqual(visited, []int{999, 998, 997}) {
+		t.Errorf("Expected to visit the last three indexes, got %v", visited)
+	}
+}
+
+// The slice benchmarks reproduce how lists were stored before store.List:
+// LPUSH rebuilt the whole slice and LPOP resliced it.
+
+const benchmarkListSize = 10000
+
+func BenchmarkListLPush(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		list := store.NewList()
+		for j := 0; j < benchmarkListSize; j++ {
+			list.PushLeft("element")
+		}
+	}
+}
+
+func BenchmarkSliceLPush(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		list := []string{}
+		for j := 0; j < benchmarkListSize; j++ {
+			newList := make([]string, 0, len(list)+1)
+			newList = append(newList, "element")
+			list = append(newList, list...)
+		}
+	}
+}
+
+func BenchmarkListRPushLPop(b *testing.B) {
+	list := store.NewList()
+	for i := 0; i < b.N; i++ {
+		list.PushRight("element")
+		if list.Len() > benchmarkListSize {
+			list.PopLeft()
+		}
+	}
+}
+
+func BenchmarkSliceRPushLPop(b *testing.B) {
+	list := []string{}
+	for i := 0; i < b.N; i++ {
+		list = append(list, "element")
+		if len(list) > benchmarkListSize {
+			list = list[1:]
+		}
+	}
+}
+
+func BenchmarkListLIndexMiddle(b *testing.B) {
+	list := store.NewList()
+	for j := 0; j < benchmarkListSize; j++ {
+		list.PushRight("element")
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		list.Index(benchmarkListSize / 2)
+	}
+}
+
+func BenchmarkListLRange100(b *testing.B) {
+	list := store.NewList()
+	for j := 0; j < benchmarkListSize; j++ {
+		list.PushRight("element")
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		list.Range(benchmarkListSize/2, benchmarkListSize/2+99)
+	}
+}
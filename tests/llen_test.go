@@ -43,7 +43,7 @@ func TestLLenCommand(t *testing.T) {
 		{
 			name: "Get length of empty list returns zero",
 			setup: func() {
-				store.Set("emptylist", &store.Value{ListData: []string{}})
+				store.Set("emptylist", &store.Value{ListData: store.NewList()})
 			},
 			args:     []string{"emptylist"},
 			expected: ":0\r\n",
@@ -0,0 +1,242 @@
+package tests
+
+import (
+	"net"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	command "github.com/SuchintK/GoDisKV/commands"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+func setupLMoveTestClient() *client.Client {
+	conn1, _ := net.Pipe()
+	cli := client.New(conn1)
+	return &cli
+}
+
+func TestLMoveCommand(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func()
+		command  string
+		args     []string
+		expected string
+		src      []string
+		dst      []string
+	}{
+		{
+			name: "Move tail of source to head of destination",
+			setup: func() {
+				store.Delete("src")
+				store.Delete("dst")
+				cli := setupLMoveTestClient()
+				command.New("rpush", []string{"src", "a", "b", "c"}).Execute(cli)
+				command.New("rpush", []string{"dst", "x"}).Execute(cli)
+			},
+			command:  "lmove",
+			args:     []string{"src", "dst", "RIGHT", "LEFT"},
+			expected: "$1\r\nc\r\n",
+			src:      []string{"a", "b"},
+			dst:      []string{"c", "x"},
+		},
+		{
+			name: "Move head of source to tail of new destination",
+			setup: func() {
+				store.Delete("src")
+				store.Delete("dst")
+				cli := setupLMoveTestClient()
+				command.New("rpush", []string{"src", "a", "b"}).Execute(cli)
+			},
+			command:  "lmove",
+			args:     []string{"src", "dst", "LEFT", "RIGHT"},
+			expected: "$1\r\na\r\n",
+			src:      []string{"b"},
+			dst:      []string{"a"},
+		},
+		{
+			name: "Rotate a list onto itself",
+			setup: func() {
+				store.Delete("src")
+				cli := setupLMoveTestClient()
+				command.New("rpush", []string{"src", "a", "b", "c"}).Execute(cli)
+			},
+			command:  "lmove",
+			args:     []string{"src", "src", "LEFT", "RIGHT"},
+			expected: "$1\r\na\r\n",
+			src:      []string{"b", "c", "a"},
+			dst:      []string{"b", "c", "a"},
+		},
+		{
+			name: "Rotate single element list onto itself",
+			setup: func() {
+				store.Delete("src")
+				cli := setupLMoveTestClient()
+				command.New("rpush", []string{"src", "a"}).Execute(cli)
+			},
+			command:  "lmove",
+			args:     []string{"src", "src", "RIGHT", "LEFT"},
+			expected: "$1\r\na\r\n",
+			src:      []string{"a"},
+			dst:      []string{"a"},
+		},
+		{
+			name: "Moving last element deletes source",
+			setup: func() {
+				store.Delete("src")
+				store.Delete("dst")
+				cli := setupLMoveTestClient()
+				command.New("rpush", []string{"src", "a"}).Execute(cli)
+			},
+			command:  "rpoplpush",
+			args:     []string{"src", "dst"},
+			expected: "$1\r\na\r\n",
+			src:      nil,
+			dst:      []string{"a"},
+		},
+		{
+			name: "Missing source",
+			setup: func() {
+				store.Delete("src")
+				store.Delete("dst")
+			},
+			command:  "lmove",
+			args:     []string{"src", "dst", "LEFT", "LEFT"},
+			expected: "$-1\r\n",
+			src:      nil,
+			dst:      nil,
+		},
+		{
+			name: "Wrong type destination leaves source untouched",
+			setup: func() {
+				store.Delete("src")
+				store.Delete("dst")
+				cli := setupLMoveTestClient()
+				command.New("rpush", []string{"src", "a"}).Execute(cli)
+				command.New("set", []string{"dst", "value"}).Execute(cli)
+			},
+			command:  "lmove",
+			args:     []string{"src", "dst", "LEFT", "LEFT"},
+			expected: "-WRONGTYPE Operation against a key holding the wrong kind of value\r\n",
+			src:      []string{"a"},
+		},
+		{
+			name: "Error on invalid direction",
+			setup: func() {
+			},
+			command:  "lmove",
+			args:     []string{"src", "dst", "UP", "LEFT"},
+			expected: "-syntax error\r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			cli := setupLMoveTestClient()
+			cmd := command.New(tt.command, tt.args)
+			result := cmd.Execute(cli)
+
+			if string(result) != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, string(result))
+			}
+
+			if tt.src != nil {
+				val, _ := store.Get("src")
+				if !reflect.DeepEqual(val.ListData.Elements(), tt.src) {
+					t.Errorf("Expected source %v, got %v", tt.src, val.ListData.Elements())
+				}
+			}
+			if tt.dst != nil {
+				key := "dst"
+				if tt.args[0] == tt.args[1] {
+					key = "src"
+				}
+				val, _ := store.Get(key)
+				if !reflect.DeepEqual(val.ListData.Elements(), tt.dst) {
+					t.Errorf("Expected destination %v, got %v", tt.dst, val.ListData.Elements())
+				}
+			}
+		})
+	}
+}
+
+func TestBLMoveTimeout(t *testing.T) {
+	store.Delete("src")
+	cli := setupLMoveTestClient()
+
+	start := time.Now()
+	result := command.New("blmove", []string{"src", "dst", "LEFT", "RIGHT", "0.1"}).Execute(cli)
+	elapsed := time.Since(start)
+
+	if string(result) != "*-1\r\n" {
+		t.Errorf("Expected null array on timeout, got %q", string(result))
+	}
+	if elapsed < 100*time.Millisecond {
+		t.Errorf("Expected to wait at least 100ms, but took %v", elapsed)
+	}
+	if len(cli.TakePropagated()) != 0 {
+		t.Error("Expected nothing to be replicated on timeout")
+	}
+}
+
+func TestBRPopLPushUnblocksAndReplicatesAsLMove(t *testing.T) {
+	store.Delete("src")
+	store.Delete("dst")
+	cli := setupLMoveTestClient()
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		producer := setupLMoveTestClient()
+		command.New("rpush", []string{"src", "job"}).Execute(producer)
+	}()
+
+	result := command.New("brpoplpush", []string{"src", "dst", "1"}).Execute(cli)
+	if string(result) != "$3\r\njob\r\n" {
+		t.Fatalf("Expected job to be moved, got %q", string(result))
+	}
+
+	expected := [][]string{{"lmove", "src", "dst", "right", "left"}}
+	if propagated := cli.TakePropagated(); !reflect.DeepEqual(propagated, expected) {
+		t.Errorf("Expected %v to be replicated, got %v", expected, propagated)
+	}
+}
+
+func TestLMoveConcurrentMovesKeepEveryElement(t *testing.T) {
+	store.Delete("queue")
+	store.Delete("processing")
+	producer := setupLMoveTestClient()
+
+	const items = 200
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < items; i++ {
+			command.New("rpush", []string{"queue", "job"}).Execute(producer)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		worker := setupLMoveTestClient()
+		moved := 0
+		for moved < items {
+			result := command.New("lmove", []string{"queue", "processing", "LEFT", "RIGHT"}).Execute(worker)
+			if string(result) != "$-1\r\n" {
+				moved++
+			}
+		}
+	}()
+	wg.Wait()
+
+	val, _ := store.Get("processing")
+	if val.ListData.Len() != items {
+		t.Errorf("Expected %d moved elements, got %d", items, val.ListData.Len())
+	}
+	if _, exists := store.Get("queue"); exists {
+		t.Error("Expected queue to be drained")
+	}
+}
@@ -0,0 +1,206 @@
+package tests
+
+import (
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	command "github.com/SuchintK/GoDisKV/commands"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+func setupLMPopTestClient() *client.Client {
+	conn1, _ := net.Pipe()
+	cli := client.New(conn1)
+	return &cli
+}
+
+func TestLMPopCommand(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func()
+		args     []string
+		expected string
+		remains  []string
+	}{
+		{
+			name: "Pop one element from the left by default",
+			setup: func() {
+				store.Delete("list1")
+				cli := setupLMPopTestClient()
+				command.New("rpush", []string{"list1", "a", "b", "c"}).Execute(cli)
+			},
+			args:     []string{"1", "list1", "LEFT"},
+			expected: "*2\r\n$5\r\nlist1\r\n*1\r\n$1\r\na\r\n",
+			remains:  []string{"b", "c"},
+		},
+		{
+			name: "Pop several elements from the right",
+			setup: func() {
+				store.Delete("list1")
+				cli := setupLMPopTestClient()
+				command.New("rpush", []string{"list1", "a", "b", "c"}).Execute(cli)
+			},
+			args:     []string{"1", "list1", "RIGHT", "COUNT", "2"},
+			expected: "*2\r\n$5\r\nlist1\r\n*2\r\n$1\r\nc\r\n$1\r\nb\r\n",
+			remains:  []string{"a"},
+		},
+		{
+			name: "Count larger than list pops everything",
+			setup: func() {
+				store.Delete("list1")
+				cli := setupLMPopTestClient()
+				command.New("rpush", []string{"list1", "a", "b"}).Execute(cli)
+			},
+			args:     []string{"1", "list1", "LEFT", "COUNT", "10"},
+			expected: "*2\r\n$5\r\nlist1\r\n*2\r\n$1\r\na\r\n$1\r\nb\r\n",
+		},
+		{
+			name: "Skip empty keys",
+			setup: func() {
+				store.Delete("list1")
+				store.Delete("list2")
+				cli := setupLMPopTestClient()
+				command.New("rpush", []string{"list2", "x"}).Execute(cli)
+			},
+			args:     []string{"2", "list1", "list2", "LEFT"},
+			expected: "*2\r\n$5\r\nlist2\r\n*1\r\n$1\r\nx\r\n",
+		},
+		{
+			name: "Null array when all keys are empty",
+			setup: func() {
+				store.Delete("list1")
+				store.Delete("list2")
+			},
+			args:     []string{"2", "list1", "list2", "LEFT"},
+			expected: "*-1\r\n",
+		},
+		{
+			name: "Error on wrong type",
+			setup: func() {
+				store.Delete("list1")
+				store.Delete("mystring")
+				cli := setupLMPopTestClient()
+				command.New("set", []string{"mystring", "value"}).Execute(cli)
+			},
+			args:     []string{"2", "list1", "mystring", "LEFT"},
+			expected: "-WRONGTYPE Operation against a key holding the wrong kind of value\r\n",
+		},
+		{
+			name:     "Error on zero numkeys",
+			setup:    func() {},
+			args:     []string{"0", "list1", "LEFT"},
+			expected: "-ERR numkeys should be greater than 0\r\n",
+		},
+		{
+			name:     "Error on zero count",
+			setup:    func() {},
+			args:     []string{"1", "list1", "LEFT", "COUNT", "0"},
+			expected: "-ERR count should be greater than 0\r\n",
+		},
+		{
+			name:     "Error on invalid direction",
+			setup:    func() {},
+			args:     []string{"1", "list1", "UP"},
+			expected: "-syntax error\r\n",
+		},
+		{
+			name:     "Error when numkeys exceeds the keys given",
+			setup:    func() {},
+			args:     []string{"3", "list1", "LEFT"},
+			expected: "-syntax error\r\n",
+		},
+		{
+			name:     "Error when numkeys is the largest integer",
+			setup:    func() {},
+			args:     []string{"9223372036854775807", "list1", "LEFT"},
+			expected: "-syntax error\r\n",
+		},
+		{
+			name:     "Error on wrong number of arguments",
+			setup:    func() {},
+			args:     []string{"1", "list1"},
+			expected: "-wrong number of arguments\r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			cli := setupLMPopTestClient()
+			result := command.New("lmpop", tt.args).Execute(cli)
+
+			if string(result) != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, string(result))
+			}
+			if tt.remains != nil {
+				val, _ := store.Get("list1")
+				if !reflect.DeepEqual(val.ListData.Elements(), tt.remains) {
+					t.Errorf("Expected remaining %v, got %v", tt.remains, val.ListData.Elements())
+				}
+			}
+		})
+	}
+}
+
+func TestBLMPopTimeout(t *testing.T) {
+	store.Delete("list1")
+	cli := setupLMPopTestClient()
+
+	start := time.Now()
+	result := command.New("blmpop", []string{"0.1", "1", "list1", "LEFT"}).Execute(cli)
+	elapsed := time.Since(start)
+
+	if string(result) != "*-1\r\n" {
+		t.Errorf("Expected null array on timeout, got %q", string(result))
+	}
+	if elapsed < 100*time.Millisecond {
+		t.Errorf("Expected to wait at least 100ms, but took %v", elapsed)
+	}
+}
+
+func TestBLMPopUnblocksAndReplicatesAsLMPop(t *testing.T) {
+	store.Delete("list1")
+	store.Delete("list2")
+	cli := setupLMPopTestClient()
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		producer := setupLMPopTestClient()
+		command.New("rpush", []string{"list2", "a", "b", "c"}).Execute(producer)
+	}()
+
+	result := command.New("blmpop", []string{"1", "2", "list1", "list2", "RIGHT", "COUNT", "2"}).Execute(cli)
+	expected := "*2\r\n$5\r\nlist2\r\n*2\r\n$1\r\nc\r\n$1\r\nb\r\n"
+	if string(result) != expected {
+		t.Fatalf("Expected %q, got %q", expected, string(result))
+	}
+
+	propagated := [][]string{{"lmpop", "1", "list2", "right", "count", "2"}}
+	if got := cli.TakePropagated(); !reflect.DeepEqual(got, propagated) {
+		t.Errorf("Expected %v to be replicated, got %v", propagated, got)
+	}
+}
+
+func TestBLMPopWrongType(t *testing.T) {
+	store.Delete("mystring")
+	cli := setupLMPopTestClient()
+	command.New("set", []string{"mystring", "value"}).Execute(cli)
+
+	start := time.Now()
+	result := command.New("blmpop", []string{"0.5", "1", "mystring", "LEFT"}).Execute(cli)
+	elapsed := time.Since(start)
+
+	expected := "-WRONGTYPE Operation against a key holding the wrong kind of value\r\n"
+	if string(result) != expected {
+		t.Errorf("Expected %q, got %q", expected, string(result))
+	}
+	if elapsed >= 500*time.Millisecond {
+		t.Errorf("Expected an immediate error instead of blocking, took %v", elapsed)
+	}
+	if len(cli.TakePropagated()) != 0 {
+		t.Error("Expected nothing to be replicated on error")
+	}
+}
@@ -37,11 +37,11 @@ func TestLPopCommand(t *testing.T) {
 				if !exists {
 					t.Fatal("Expected list to exist")
 				}
-				if len(val.ListData) != 2 {
-					t.Errorf("Expected 2 elements remaining, got %d", len(val.ListData))
+				if val.ListData.Len() != 2 {
+					t.Errorf("Expected 2 elements remaining, got %d", val.ListData.Len())
 				}
-				if val.ListData[0] != "two" {
-					t.Errorf("Expected first element to be 'two', got %s", val.ListData[0])
+				if val.ListData.Index(0) != "two" {
+					t.Errorf("Expected first element to be 'two', got %s", val.ListData.Index(0))
 				}
 			},
 		},
@@ -72,11 +72,21 @@ func TestLPopCommand(t *testing.T) {
 		{
 			name: "Pop from empty list returns null",
 			setup: func() {
-				store.Set("emptylist", &store.Value{ListData: []string{}})
+				store.Set("emptylist", &store.Value{ListData: store.NewList()})
 			},
 			args:     []string{"emptylist"},
 			expected: "$-1\r\n",
 		},
+		{
+			name: "Error on wrong type",
+			setup: func() {
+				store.Delete("mystring")
+				cli := setupLPopTestClient()
+				command.New("set", []string{"mystring", "value"}).Execute(cli)
+			},
+			args:     []string{"mystring"},
+			expected: "-WRONGTYPE Operation against a key holding the wrong kind of value\r\n",
+		},
 		{
 			name: "Error on wrong number of arguments - no args",
 			setup: func() {
@@ -0,0 +1,104 @@
+package tests
+
+import (
+	"net"
+	"testing"
+
+	command "github.com/SuchintK/GoDisKV/commands"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+func setupLPosTestClient() *client.Client {
+	conn1, _ := net.Pipe()
+	cli := client.New(conn1)
+	return &cli
+}
+
+func TestLPosCommand(t *testing.T) {
+	setup := func() {
+		store.Delete("mylist")
+		cli := setupLPosTestClient()
+		command.New("rpush", []string{"mylist", "a", "b", "c", "1", "2", "3", "c", "c"}).Execute(cli)
+	}
+
+	tests := []struct {
+		name     string
+		args     []string
+		expected string
+	}{
+		{
+			name:     "First match",
+			args:     []string{"mylist", "c"},
+			expected: ":2\r\n",
+		},
+		{
+			name:     "RANK skips matches",
+			args:     []string{"mylist", "c", "RANK", "2"},
+			expected: ":6\r\n",
+		},
+		{
+			name:     "Negative RANK scans from tail",
+			args:     []string{"mylist", "c", "RANK", "-1"},
+			expected: ":7\r\n",
+		},
+		{
+			name:     "COUNT returns array",
+			args:     []string{"mylist", "c", "COUNT", "2"},
+			expected: "*2\r\n:2\r\n:6\r\n",
+		},
+		{
+			name:     "COUNT 0 returns all matches",
+			args:     []string{"mylist", "c", "COUNT", "0"},
+			expected: "*3\r\n:2\r\n:6\r\n:7\r\n",
+		},
+		{
+			name:     "RANK with COUNT from tail",
+			args:     []string{"mylist", "c", "RANK", "-1", "COUNT", "2"},
+			expected: "*2\r\n:7\r\n:6\r\n",
+		},
+		{
+			name:     "MAXLEN limits comparisons",
+			args:     []string{"mylist", "c", "COUNT", "0", "MAXLEN", "5"},
+			expected: "*1\r\n:2\r\n",
+		},
+		{
+			name:     "No match",
+			args:     []string{"mylist", "z"},
+			expected: "$-1\r\n",
+		},
+		{
+			name:     "No match with COUNT",
+			args:     []string{"mylist", "z", "COUNT", "1"},
+			expected: "*0\r\n",
+		},
+		{
+			name:     "Missing key",
+			args:     []string{"nolist", "a"},
+			expected: "$-1\r\n",
+		},
+		{
+			name:     "Error on zero RANK",
+			args:     []string{"mylist", "c", "RANK", "0"},
+			expected: "-ERR RANK can't be zero: use 1 to start from the first match, 2 from the second ... or use negative to start from the end of the list\r\n",
+		},
+		{
+			name:     "Error on negative COUNT",
+			args:     []string{"mylist", "c", "COUNT", "-1"},
+			expected: "-ERR COUNT can't be negative\r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setup()
+			cli := setupLPosTestClient()
+			cmd := command.New("lpos", tt.args)
+			result := cmd.Execute(cli)
+
+			if string(result) != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, string(result))
+			}
+		})
+	}
+}
@@ -35,11 +35,11 @@ func TestLPushCommand(t *testing.T) {
 				if !exists || val.ListData == nil {
 					t.Fatal("Expected list to be created")
 				}
-				if len(val.ListData) != 1 {
-					t.Errorf("Expected 1 element, got %d", len(val.ListData))
+				if val.ListData.Len() != 1 {
+					t.Errorf("Expected 1 element, got %d", val.ListData.Len())
 				}
-				if val.ListData[0] != "world" {
-					t.Errorf("Expected 'world', got %s", val.ListData[0])
+				if val.ListData.Index(0) != "world" {
+					t.Errorf("Expected 'world', got %s", val.ListData.Index(0))
 				}
 			},
 		},
@@ -55,11 +55,11 @@ func TestLPushCommand(t *testing.T) {
 				if !exists {
 					t.Fatal("Expected list to exist")
 				}
-				if len(val.ListData) != 3 {
-					t.Errorf("Expected 3 elements, got %d", len(val.ListData))
+				if val.ListData.Len() != 3 {
+					t.Errorf("Expected 3 elements, got %d", val.ListData.Len())
 				}
-				if val.ListData[0] != "three" || val.ListData[1] != "two" || val.ListData[2] != "one" {
-					t.Errorf("Expected [three, two, one], got %v", val.ListData)
+				if val.ListData.Index(0) != "three" || val.ListData.Index(1) != "two" || val.ListData.Index(2) != "one" {
+					t.Errorf("Expected [three, two, one], got %v", val.ListData.Elements())
 				}
 			},
 		},
@@ -77,11 +77,11 @@ func TestLPushCommand(t *testing.T) {
 				if !exists {
 					t.Fatal("Expected list to exist")
 				}
-				if len(val.ListData) != 2 {
-					t.Errorf("Expected 2 elements, got %d", len(val.ListData))
+				if val.ListData.Len() != 2 {
+					t.Errorf("Expected 2 elements, got %d", val.ListData.Len())
 				}
-				if val.ListData[0] != "world" || val.ListData[1] != "hello" {
-					t.Errorf("Expected [world, hello], got %v", val.ListData)
+				if val.ListData.Index(0) != "world" || val.ListData.Index(1) != "hello" {
+					t.Errorf("Expected [world, hello], got %v", val.ListData.Elements())
 				}
 			},
 		},
@@ -95,6 +95,16 @@ func TestLPushCommand(t *testing.T) {
 			args:     []string{"mystring", "element"},
 			expected: "-WRONGTYPE Operation against a key holding the wrong kind of value\r\n",
 		},
+		{
+			name: "Error on a hash key",
+			setup: func() {
+				store.Delete("myhash")
+				cli := setupLPushTestClient()
+				command.New("hset", []string{"myhash", "field", "value"}).Execute(cli)
+			},
+			args:     []string{"myhash", "element"},
+			expected: "-WRONGTYPE Operation against a key holding the wrong kind of value\r\n",
+		},
 		{
 			name: "Error on wrong number of arguments - no elements",
 			setup: func() {
@@ -0,0 +1,107 @@
+package tests
+
+import (
+	"net"
+	"reflect"
+	"testing"
+
+	command "github.com/SuchintK/GoDisKV/commands"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+func setupLRemTestClient() *client.Client {
+	conn1, _ := net.Pipe()
+	cli := client.New(conn1)
+	return &cli
+}
+
+func TestLRemAndLTrimCommands(t *testing.T) {
+	setup := func() {
+		store.Delete("mylist")
+		cli := setupLRemTestClient()
+		command.New("rpush", []string{"mylist", "a", "b", "a", "c", "a"}).Execute(cli)
+	}
+
+	tests := []struct {
+		name      string
+		command   string
+		args      []string
+		expected  string
+		remaining []string
+	}{
+		{
+			name:      "LREM positive count removes from head",
+			command:   "lrem",
+			args:      []string{"mylist", "2", "a"},
+			expected:  ":2\r\n",
+			remaining: []string{"b", "c", "a"},
+		},
+		{
+			name:      "LREM negative count removes from tail",
+			command:   "lrem",
+			args:      []string{"mylist", "-2", "a"},
+			expected:  ":2\r\n",
+			remaining: []string{"a", "b", "c"},
+		},
+		{
+			name:      "LREM zero count removes all",
+			command:   "lrem",
+			args:      []string{"mylist", "0", "a"},
+			expected:  ":3\r\n",
+			remaining: []string{"b", "c"},
+		},
+		{
+			name:      "LREM missing element",
+			command:   "lrem",
+			args:      []string{"mylist", "0", "z"},
+			expected:  ":0\r\n",
+			remaining: []string{"a", "b", "a", "c", "a"},
+		},
+		{
+			name:      "LTRIM keeps range",
+			command:   "ltrim",
+			args:      []string{"mylist", "1", "-2"},
+			expected:  "+OK\r\n",
+			remaining: []string{"b", "a", "c"},
+		},
+		{
+			name:      "LTRIM stop beyond length",
+			command:   "ltrim",
+			args:      []string{"mylist", "3", "100"},
+			expected:  "+OK\r\n",
+			remaining: []string{"c", "a"},
+		},
+		{
+			name:      "LTRIM empty range deletes key",
+			command:   "ltrim",
+			args:      []string{"mylist", "5", "10"},
+			expected:  "+OK\r\n",
+			remaining: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setup()
+			cli := setupLRemTestClient()
+			cmd := command.New(tt.command, tt.args)
+			result := cmd.Execute(cli)
+
+			if string(result) != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, string(result))
+			}
+
+			val, exists := store.Get("mylist")
+			if tt.remaining == nil {
+				if exists {
+					t.Errorf("Expected key to be deleted, got %v", val.ListData.Elements())
+				}
+				return
+			}
+			if !exists || !reflect.DeepEqual(val.ListData.Elements(), tt.remaining) {
+				t.Errorf("Expected remaining %v, got %v", tt.remaining, val.ListData.Elements())
+			}
+		})
+	}
+}
@@ -0,0 +1,203 @@
+package tests
+
+import (
+	"bytes"
+	"testing"
+
+	command "github.com/SuchintK/GoDisKV/commands"
+	"github.com/SuchintK/GoDisKV/pubsub"
+	"github.com/SuchintK/GoDisKV/resp"
+	"github.com/SuchintK/GoDisKV/resp/client"
+)
+
+// written returns everything sent to a mock client so far
+func written(cli *client.Client) string {
+	return cli.Connection().(*mockConn).String()
+}
+
+func TestSubscribeMultipleChannels(t *testing.T) {
+	pubsub.ResetGlobal()
+
+	cli := newMockClient()
+	response := command.New("subscribe", []string{"a", "b", "a"}).Execute(cli)
+	expected := "*3\r\n$9\r\nsubscribe\r\n$1\r\na\r\n:1\r\n" +
+		"*3\r\n$9\r\nsubscribe\r\n$1\r\nb\r\n:2\r\n" +
+		"*3\r\n$9\r\nsubscribe\r\n$1\r\na\r\n:2\r\n"
+	if string(response) != expected {
+		t.Errorf("Expected %q, got %q", expected, string(response))
+	}
+
+	response = command.New("unsubscribe", []string{"b", "missing"}).Execute(cli)
+	expected = "*3\r\n$11\r\nunsubscribe\r\n$1\r\nb\r\n:1\r\n" +
+		"*3\r\n$11\r\nunsubscribe\r\n$7\r\nmissing\r\n:1\r\n"
+	if string(response) != expected {
+		t.Errorf("Expected %q, got %q", expected, string(response))
+	}
+}
+
+func TestUnsubscribeAllRepliesPerChannel(t *testing.T) {
+	pubsub.ResetGlobal()
+
+	cli := newMockClient()
+	command.New("subscribe", []string{"b", "a"}).Execute(cli)
+	command.New("psubscribe", []string{"p*"}).Execute(cli)
+
+	response := command.New("unsubscribe", []string{}).Execute(cli)
+	expected := "*3\r\n$11\r\nunsubscribe\r\n$1\r\na\r\n:2\r\n" +
+		"*3\r\n$11\r\nunsubscribe\r\n$1\r\nb\r\n:1\r\n"
+	if string(response) != expected {
+		t.Errorf("Expected %q, got %q", expected, string(response))
+	}
+
+	// Nothing left to unsubscribe from
+	response = command.New("unsubscribe", []string{}).Execute(cli)
+	expected = "*3\r\n$11\r\nunsubscribe\r\n$-1\r\n:1\r\n"
+	if string(response) != expected {
+		t.Errorf("Expected %q, got %q", expected, string(response))
+	}
+	if !cli.IsSubscribed() {
+		t.Error("Client should stay in subscribed mode while a pattern subscription remains")
+	}
+}
+
+func TestPSubscribeCommand(t *testing.T) {
+	pubsub.ResetGlobal()
+
+	cli := newMockClient()
+	command.New("subscribe", []string{"news"}).Execute(cli)
+
+	response := command.New("psubscribe", []string{"news.*", "h?llo"}).Execute(cli)
+	expected := "*3\r\n$10\r\npsubscribe\r\n$6\r\nnews.*\r\n:2\r\n" +
+		"*3\r\n$10\r\npsubscribe\r\n$5\r\nh?llo\r\n:3\r\n"
+	if string(response) != expected {
+		t.Errorf("Expected %q, got %q", expected, string(response))
+	}
+	if cli.SubscriptionCount() != 3 {
+		t.Errorf("Expected 3 subscriptions, got %d", cli.SubscriptionCount())
+	}
+
+	response = command.New("psubscribe", []string{}).Execute(cli)
+	if string(response) != "-wrong number of arguments\r\n" {
+		t.Errorf("Expected wrong number of arguments error, got %q", string(response))
+	}
+}
+
+func TestPublishDeliversPatternMessages(t *testing.T) {
+	pubsub.ResetGlobal()
+
+	exact := newMockClient()
+	pattern := newMockClient()
+	both := newMockClient()
+	command.New("subscribe", []string{"news.tech"}).Execute(exact)
+	command.New("psubscribe", []string{"news.*"}).Execute(pattern)
+	command.New("subscribe", []string{"news.tech"}).Execute(both)
+	command.New("psubscribe", []string{"news.*", "*tech"}).Execute(both)
+	exact.Connection().(*mockConn).Reset()
+	pattern.Connection().(*mockConn).Reset()
+	both.Connection().(*mockConn).Reset()
+
+	publisher := newMockClient()
+	response := command.New("publish", []string{"news.tech", "hi"}).Execute(publisher)
+	expected := resp.EncodeInteger(5)
+	if !bytes.Equal(response, expected) {
+		t.Errorf("Expected %s, got %s", string(expected), string(response))
+	}
+
+	message := string(pubsub.EncodePubSubMessage("news.tech", "hi"))
+	newsPattern := "*4\r\n$8\r\npmessage\r\n$6\r\nnews.*\r\n$9\r\nnews.tech\r\n$2\r\nhi\r\n"
+	techPattern := "*4\r\n$8\r\npmessage\r\n$5\r\n*tech\r\n$9\r\nnews.tech\r\n$2\r\nhi\r\n"
+
+	if got := written(exact); got != message {
+		t.Errorf("Exact subscriber: expected %q, got %q", message, got)
+	}
+	if got := written(pattern); got != newsPattern {
+		t.Errorf("Pattern subscriber: expected %q, got %q", newsPattern, got)
+	}
+	got := written(both)
+	if len(got) != len(message)+len(newsPattern)+len(techPattern) ||
+		!bytes.HasPrefix([]byte(got), []byte(message)) ||
+		!bytes.Contains([]byte(got), []byte(newsPattern)) ||
+		!bytes.Contains([]byte(got), []byte(techPattern)) {
+		t.Errorf("Channel and pattern subscriber: unexpected deliveries %q", got)
+	}
+
+	// Channels that do not match the pattern are not delivered
+	response = command.New("publish", []string{"sports", "goal"}).Execute(publisher)
+	expected = resp.EncodeInteger(0)
+	if !bytes.Equal(response, expected) {
+		t.Errorf("Expected %s, got %s", string(expected), string(response))
+	}
+}
+
+func TestPUnsubscribeCommand(t *testing.T) {
+	pubsub.ResetGlobal()
+
+	cli := newMockClient()
+	command.New("psubscribe", []string{"b*", "a*"}).Execute(cli)
+	command.New("subscribe", []string{"chan"}).Execute(cli)
+
+	response := command.New("punsubscribe", []string{"b*"}).Execute(cli)
+	expected := "*3\r\n$12\r\npunsubscribe\r\n$2\r\nb*\r\n:2\r\n"
+	if string(response) != expected {
+		t.Errorf("Expected %q, got %q", expected, string(response))
+	}
+
+	publisher := newMockClient()
+	response = command.New("publish", []string{"bee", "x"}).Execute(publisher)
+	if !bytes.Equal(response, resp.EncodeInteger(0)) {
+		t.Errorf("Expected no deliveries after punsubscribe, got %s", string(response))
+	}
+
+	response = command.New("punsubscribe", []string{}).Execute(cli)
+	expected = "*3\r\n$12\r\npunsubscribe\r\n$2\r\na*\r\n:1\r\n"
+	if string(response) != expected {
+		t.Errorf("Expected %q, got %q", expected, string(response))
+	}
+
+	response = command.New("punsubscribe", []string{}).Execute(cli)
+	expected = "*3\r\n$12\r\npunsubscribe\r\n$-1\r\n:1\r\n"
+	if string(response) != expected {
+		t.Errorf("Expected %q, got %q", expected, string(response))
+	}
+
+	command.New("unsubscribe", []string{}).Execute(cli)
+	if cli.IsSubscribed() {
+		t.Error("Client should leave subscribed mode once channels and patterns are gone")
+	}
+}
+
+func TestUnsubscribeAllClearsPatterns(t *testing.T) {
+	pubsub.ResetGlobal()
+
+	cli := newMockClient()
+	command.New("psubscribe", []string{"*"}).Execute(cli)
+	pubsub.Global.UnsubscribeAll(cli)
+
+	if cli.SubscriptionCount() != 0 {
+		t.Errorf("Expected 0 subscriptions, got %d", cli.SubscriptionCount())
+	}
+	response := command.New("publish", []string{"any", "x"}).Execute(newMockClient())
+	if !bytes.Equal(response, resp.EncodeInteger(0)) {
+		t.Errorf("Expected no deliveries after disconnect, got %s", string(response))
+	}
+}
+
+func TestUnsubscribeEmptyNameIsAChannel(t *testing.T) {
+	pubsub.ResetGlobal()
+
+	cli := newMockClient()
+	command.New("subscribe", []string{"", "news"}).Execute(cli)
+	command.New("psubscribe", []string{"", "news.*"}).Execute(cli)
+
+	response := command.New("unsubscribe", []string{""}).Execute(cli)
+	expected := "*3\r\n$11\r\nunsubscribe\r\n$0\r\n\r\n:3\r\n"
+	if string(response) != expected {
+		t.Errorf("Expected %q, got %q", expected, string(response))
+	}
+
+	response = command.New("punsubscribe", []string{""}).Execute(cli)
+	expected = "*3\r\n$12\r\npunsubscribe\r\n$0\r\n\r\n:2\r\n"
+	if string(response) != expected {
+		t.Errorf("Expected %q, got %q", expected, string(response))
+	}
+}
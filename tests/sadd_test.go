@@ -0,0 +1,284 @@
+package tests
+
+import (
+	"net"
+	"strconv"
+	"testing"
+
+	command "github.com/SuchintK/GoDisKV/commands"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+func setupSAddTestClient() *client.Client {
+	conn1, _ := net.Pipe()
+	cli := client.New(conn1)
+	return &cli
+}
+
+func TestSAddCommand(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func()
+		command  string
+		args     []string
+		expected string
+		validate func(t *testing.T)
+	}{
+		{
+			name: "Add members to new set",
+			setup: func() {
+				store.Delete("myset")
+			},
+			command:  "sadd",
+			args:     []string{"myset", "a", "b", "a"},
+			expected: ":2\r\n",
+			validate: func(t *testing.T) {
+				val, exists := store.Get("myset")
+				if !exists || val.SetData == nil {
+					t.Fatal("Expected set to be created")
+				}
+				if val.SetData.Card() != 2 {
+					t.Errorf("Expected 2 members, got %d", val.SetData.Card())
+				}
+			},
+		},
+		{
+			name: "Integer members use intset encoding",
+			setup: func() {
+				store.Delete("myset")
+			},
+			command:  "sadd",
+			args:     []string{"myset", "3", "1", "2"},
+			expected: ":3\r\n",
+			validate: func(t *testing.T) {
+				val, _ := store.Get("myset")
+				if !val.SetData.IsIntset() {
+					t.Error("Expected intset encoding for small integer set")
+				}
+				members := val.SetData.Members()
+				if len(members) != 3 || members[0] != "1" || members[2] != "3" {
+					t.Errorf("Expected members in numeric order, got %v", members)
+				}
+			},
+		},
+		{
+			name: "Non-canonical integer converts to hash table",
+			setup: func() {
+				store.Delete("myset")
+				cli := setupSAddTestClient()
+				command.New("sadd", []string{"myset", "1", "2"}).Execute(cli)
+			},
+			command:  "sadd",
+			args:     []string{"myset", "01"},
+			expected: ":1\r\n",
+			validate: func(t *testing.T) {
+				val, _ := store.Get("myset")
+				if val.SetData.IsIntset() {
+					t.Error("Expected hash table encoding after adding non-canonical integer")
+				}
+				if !val.SetData.Contains("1") || !val.SetData.Contains("01") {
+					t.Error("Expected both 1 and 01 to be members")
+				}
+			},
+		},
+		{
+			name: "SREM removes members and deletes empty key",
+			setup: func() {
+				store.Delete("myset")
+				cli := setupSAddTestClient()
+				command.New("sadd", []string{"myset", "a", "b"}).Execute(cli)
+			},
+			command:  "srem",
+			args:     []string{"myset", "a", "b", "c"},
+			expected: ":2\r\n",
+			validate: func(t *testing.T) {
+				if _, exists := store.Get("myset"); exists {
+					t.Error("Expected key to be deleted after removing all members")
+				}
+			},
+		},
+		{
+			name: "SISMEMBER",
+			setup: func() {
+				store.Delete("myset")
+				cli := setupSAddTestClient()
+				command.New("sadd", []string{"myset", "a"}).Execute(cli)
+			},
+			command:  "sismember",
+			args:     []string{"myset", "a"},
+			expected: ":1\r\n",
+		},
+		{
+			name: "SMISMEMBER",
+			setup: func() {
+				store.Delete("myset")
+				cli := setupSAddTestClient()
+				command.New("sadd", []string{"myset", "a", "c"}).Execute(cli)
+			},
+			command:  "smismember",
+			args:     []string{"myset", "a", "b", "c"},
+			expected: "*3\r\n:1\r\n:0\r\n:1\r\n",
+		},
+		{
+			name: "SCARD on missing key",
+			setup: func() {
+				store.Delete("myset")
+			},
+			command:  "scard",
+			args:     []string{"myset"},
+			expected: ":0\r\n",
+		},
+		{
+			name: "SMEMBERS",
+			setup: func() {
+				store.Delete("myset")
+				cli := setupSAddTestClient()
+				command.New("sadd", []string{"myset", "b", "a"}).Execute(cli)
+			},
+			command:  "smembers",
+			args:     []string{"myset"},
+			expected: "*2\r\n$1\r\na\r\n$1\r\nb\r\n",
+		},
+		{
+			name: "SPOP with count larger than set empties it",
+			setup: func() {
+				store.Delete("myset")
+				cli := setupSAddTestClient()
+				command.New("sadd", []string{"myset", "a"}).Execute(cli)
+			},
+			command:  "spop",
+			args:     []string{"myset", "5"},
+			expected: "*1\r\n$1\r\na\r\n",
+			validate: func(t *testing.T) {
+				if _, exists := store.Get("myset"); exists {
+					t.Error("Expected key to be deleted after popping all members")
+				}
+			},
+		},
+		{
+			name: "SPOP on missing key",
+			setup: func() {
+				store.Delete("myset")
+			},
+			command:  "spop",
+			args:     []string{"myset"},
+			expected: "$-1\r\n",
+		},
+		{
+			name: "SMOVE moves member between sets",
+			setup: func() {
+				store.Delete("src")
+				store.Delete("dst")
+				cli := setupSAddTestClient()
+				command.New("sadd", []string{"src", "a"}).Execute(cli)
+			},
+			command:  "smove",
+			args:     []string{"src", "dst", "a"},
+			expected: ":1\r\n",
+			validate: func(t *testing.T) {
+				if _, exists := store.Get("src"); exists {
+					t.Error("Expected empty source to be deleted")
+				}
+				val, exists := store.Get("dst")
+				if !exists || !val.SetData.Contains("a") {
+					t.Error("Expected member to be moved to destination")
+				}
+			},
+		},
+		{
+			name: "Error on wrong type",
+			setup: func() {
+				store.Delete("mystring")
+				cli := setupSAddTestClient()
+				command.New("set", []string{"mystring", "value"}).Execute(cli)
+			},
+			command:  "sadd",
+			args:     []string{"mystring", "a"},
+			expected: "-WRONGTYPE Operation against a key holding the wrong kind of value\r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			cli := setupSAddTestClient()
+			cmd := command.New(tt.command, tt.args)
+			result := cmd.Execute(cli)
+
+			if string(result) != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, string(result))
+			}
+
+			if tt.validate != nil {
+				tt.validate(t)
+			}
+		})
+	}
+}
+
+func TestSetIntsetConvertsWhenLarge(t *testing.T) {
+	store.Delete("myset")
+	cli := setupSAddTestClient()
+
+	args := []string{"myset"}
+	for i := 0; i < 600; i++ {
+		args = append(args, strconv.Itoa(i))
+	}
+	command.New("sadd", args).Execute(cli)
+
+	val, _ := store.Get("myset")
+	if val.SetData.IsIntset() {
+		t.Error("Expected large set to use hash table encoding")
+	}
+	if val.SetData.Card() != 600 {
+		t.Errorf("Expected 600 members, got %d", val.SetData.Card())
+	}
+}
+
+func TestSPopPropagatesSRem(t *testing.T) {
+	store.Delete("myset")
+	cli := setupSAddTestClient()
+	command.New("sadd", []string{"myset", "a"}).Execute(cli)
+
+	command.New("spop", []string{"myset"}).Execute(cli)
+
+	propagated := cli.TakePropagated()
+	if len(propagated) != 1 || len(propagated[0]) != 3 || propagated[0][0] != "srem" || propagated[0][2] != "a" {
+		t.Errorf("Expected SPOP to be replicated as SREM myset a, got %v", propagated)
+	}
+}
+
+func TestSPopCountReturnsDistinctMembers(t *testing.T) {
+	store.Delete("myset")
+	cli := setupSAddTestClient()
+
+	args := []string{"myset"}
+	for i := 0; i < 600; i++ {
+		args = append(args, "m"+strconv.Itoa(i))
+	}
+	command.New("sadd", args).Execute(cli)
+	command.New("spop", []string{"myset", "250"}).Execute(cli)
+
+	propagated := cli.TakePropagated()
+	if len(propagated) != 1 || len(propagated[0]) != 252 {
+		t.Fatalf("Expected 250 members to be replicated as SREM, got %d commands", len(propagated))
+	}
+	popped := make(map[string]bool)
+	for _, member := range propagated[0][2:] {
+		popped[member] = true
+	}
+	if len(popped) != 250 {
+		t.Errorf("Expected 250 distinct members, got %d", len(popped))
+	}
+
+	val, _ := store.Get("myset")
+	if val.SetData.Card() != 350 {
+		t.Errorf("Expected 350 members left, got %d", val.SetData.Card())
+	}
+	for member := range popped {
+		if val.SetData.Contains(member) {
+			t.Errorf("Expected popped member %s to be removed", member)
+		}
+	}
+}
@@ -0,0 +1,182 @@
+package tests
+
+import (
+	"net"
+	"testing"
+
+	command "github.com/SuchintK/GoDisKV/commands"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+func setupSInterTestClient() *client.Client {
+	conn1, _ := net.Pipe()
+	cli := client.New(conn1)
+	return &cli
+}
+
+func TestSetAlgebraCommands(t *testing.T) {
+	setup := func() {
+		store.Delete("s1")
+		store.Delete("s2")
+		store.Delete("s3")
+		store.Delete("dest")
+		cli := setupSInterTestClient()
+		command.New("sadd", []string{"s1", "a", "b", "c"}).Execute(cli)
+		command.New("sadd", []string{"s2", "b", "c", "d"}).Execute(cli)
+		command.New("sadd", []string{"s3", "c"}).Execute(cli)
+	}
+
+	tests := []struct {
+		name     string
+		command  string
+		args     []string
+		expected string
+		validate func(t *testing.T)
+	}{
+		{
+			name:     "SINTER",
+			command:  "sinter",
+			args:     []string{"s1", "s2"},
+			expected: "*2\r\n$1\r\nb\r\n$1\r\nc\r\n",
+		},
+		{
+			name:     "SINTER with missing key is empty",
+			command:  "sinter",
+			args:     []string{"s1", "missing"},
+			expected: "*0\r\n",
+		},
+		{
+			name:     "SUNION",
+			command:  "sunion",
+			args:     []string{"s1", "s2"},
+			expected: "*4\r\n$1\r\na\r\n$1\r\nb\r\n$1\r\nc\r\n$1\r\nd\r\n",
+		},
+		{
+			name:     "SDIFF",
+			command:  "sdiff",
+			args:     []string{"s1", "s2"},
+			expected: "*1\r\n$1\r\na\r\n",
+		},
+		{
+			name:     "SINTERSTORE",
+			command:  "sinterstore",
+			args:     []string{"dest", "s1", "s2", "s3"},
+			expected: ":1\r\n",
+			validate: func(t *testing.T) {
+				val, exists := store.Get("dest")
+				if !exists || !val.SetData.Contains("c") {
+					t.Error("Expected dest to hold the intersection")
+				}
+			},
+		},
+		{
+			name:     "SDIFFSTORE with empty result deletes destination",
+			command:  "sdiffstore",
+			args:     []string{"dest", "s3", "s1"},
+			expected: ":0\r\n",
+			validate: func(t *testing.T) {
+				if _, exists := store.Get("dest"); exists {
+					t.Error("Expected empty result to delete destination")
+				}
+			},
+		},
+		{
+			name:     "SUNIONSTORE",
+			command:  "sunionstore",
+			args:     []string{"dest", "s1", "s3"},
+			expected: ":3\r\n",
+		},
+		{
+			name:     "SINTERCARD",
+			command:  "sintercard",
+			args:     []string{"2", "s1", "s2"},
+			expected: ":2\r\n",
+		},
+		{
+			name:     "SINTERCARD with LIMIT",
+			command:  "sintercard",
+			args:     []string{"2", "s1", "s2", "LIMIT", "1"},
+			expected: ":1\r\n",
+		},
+		{
+			name:     "SINTERCARD with LIMIT above the cardinality",
+			command:  "sintercard",
+			args:     []string{"2", "s1", "s2", "LIMIT", "10"},
+			expected: ":2\r\n",
+		},
+		{
+			name:     "SINTERCARD numkeys larger than args",
+			command:  "sintercard",
+			args:     []string{"3", "s1", "s2"},
+			expected: "-ERR Number of keys can't be greater than number of args\r\n",
+		},
+		{
+			name:     "SRANDMEMBER rejects a count out of range",
+			command:  "srandmember",
+			args:     []string{"s1", "-9223372036854775808"},
+			expected: "-ERR value is out of range\r\n",
+		},
+		{
+			name:     "SSCAN with MATCH",
+			command:  "sscan",
+			args:     []string{"s2", "0", "MATCH", "[bc]"},
+			expected: "*2\r\n$1\r\n0\r\n*2\r\n$1\r\nb\r\n$1\r\nc\r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setup()
+			cli := setupSInterTestClient()
+			cmd := command.New(tt.command, tt.args)
+			result := cmd.Execute(cli)
+
+			if string(result) != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, string(result))
+			}
+
+			if tt.validate != nil {
+				tt.validate(t)
+			}
+		})
+	}
+}
+
+func TestSetAlgebraWrongType(t *testing.T) {
+	store.Delete("s1")
+	store.Delete("mystring")
+	cli := setupSInterTestClient()
+	command.New("sadd", []string{"s1", "a"}).Execute(cli)
+	command.New("set", []string{"mystring", "value"}).Execute(cli)
+
+	result := command.New("sunion", []string{"s1", "mystring"}).Execute(cli)
+	expected := "-WRONGTYPE Operation against a key holding the wrong kind of value\r\n"
+	if string(result) != expected {
+		t.Errorf("Expected %q, got %q", expected, string(result))
+	}
+}
+
+func TestSScanCursorSurvivesDeletes(t *testing.T) {
+	store.Delete("s1")
+	cli := setupSInterTestClient()
+	command.New("sadd", []string{"s1", "a", "b", "c"}).Execute(cli)
+
+	steps := []struct {
+		command  string
+		args     []string
+		expected string
+	}{
+		{"sscan", []string{"s1", "0", "COUNT", "2"}, "*2\r\n$1\r\n2\r\n*2\r\n$1\r\na\r\n$1\r\nb\r\n"},
+		{"srem", []string{"s1", "a"}, ":1\r\n"},
+		{"sadd", []string{"s1", "0"}, ":1\r\n"},
+		{"sscan", []string{"s1", "2"}, "*2\r\n$1\r\n0\r\n*2\r\n$1\r\nc\r\n$1\r\n0\r\n"},
+	}
+
+	for _, step := range steps {
+		result := command.New(step.command, step.args).Execute(cli)
+		if string(result) != step.expected {
+			t.Errorf("%s %v: expected %q, got %q", step.command, step.args, step.expected, string(result))
+		}
+	}
+}
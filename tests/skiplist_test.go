@@ -0,0 +1,148 @@
+package tests
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+// sortedModel returns the members of model in skip list order
+func sortedModel(model map[string]float64) []string {
+	members := make([]string, 0, len(model))
+	for member := range model {
+		members = append(members, member)
+	}
+	sort.Slice(members, func(i, j int) bool {
+		a, b := members[i], members[j]
+		return model[a] < model[b] || (model[a] == model[b] && a < b)
+	})
+	return members
+}
+
+func nodeMembers(nodes []*store.SkipListNode) []string {
+	members := make([]string, len(nodes))
+	for i, node := range nodes {
+		members[i] = node.Member
+	}
+	return members
+}
+
+// TestSkipListRanksMatchModel checks span bookkeeping against a sorted model
+// while members are inserted, re-scored and deleted
+func TestSkipListRanksMatchModel(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	sl := store.NewSkipList()
+	model := map[string]float64{}
+
+	for op := 0; op < 5000; op++ {
+		member := "m" + strconv.Itoa(rng.Intn(500))
+		score, exists := model[member]
+		if exists {
+			sl.Delete(score, member)
+			delete(model, member)
+		}
+		if !exists || rng.Intn(2) == 0 {
+			score = float64(rng.Intn(100))
+			sl.Insert(score, member)
+			model[member] = score
+		}
+
+		if op%250 != 0 {
+			continue
+		}
+
+		ordered := sortedModel(model)
+		if sl.Length() != len(ordered) {
+			t.Fatalf("op %d: length %d, want %d", op, sl.Length(), len(ordered))
+		}
+		for rank, m := range ordered {
+			if got := sl.GetRank(model[m], m); got != rank {
+				t.Fatalf("op %d: rank of %s is %d, want %d", op, m, got, rank)
+			}
+		}
+		if got := sl.GetRank(1000, "missing"); got != -1 {
+			t.Fatalf("op %d: rank of missing member is %d", op, got)
+		}
+
+		if len(ordered) > 10 {
+			start, stop := len(ordered)/4, len(ordered)/2
+			if got := nodeMembers(sl.GetRange(start, stop)); !reflect.DeepEqual(got, ordered[start:stop+1]) {
+				t.Fatalf("op %d: GetRange(%d, %d) = %v", op, start, stop, got)
+			}
+
+			reversed := make([]string, len(ordered))
+			for i, m := range ordered {
+				reversed[len(ordered)-1-i] = m
+			}
+			if got := nodeMembers(sl.GetRevRange(start, stop)); !reflect.DeepEqual(got, reversed[start:stop+1]) {
+				t.Fatalf("op %d: GetRevRange(%d, %d) = %v", op, start, stop, got)
+			}
+		}
+
+		expected := 0
+		for _, m := range ordered {
+			if model[m] > 20 && model[m] <= 60 {
+				expected++
+			}
+		}
+		count := sl.CountByScore(store.ScoreBound{Value: 20, Exclusive: true}, store.ScoreBound{Value: 60})
+		if count != expected {
+			t.Fatalf("op %d: CountByScore = %d, want %d", op, count, expected)
+		}
+	}
+}
+
+const benchmarkLeaderboardSize = 1000000
+
+var (
+	benchmarkLeaderboard     *store.SkipList
+	benchmarkLeaderboardOnce sync.Once
+)
+
+// leaderboard returns a skip list of one million members shared by the benchmarks
+func leaderboard() *store.SkipList {
+	benchmarkLeaderboardOnce.Do(func() {
+		benchmarkLeaderboard = store.NewSkipList()
+		for i := 0; i < benchmarkLeaderboardSize; i++ {
+			benchmarkLeaderboard.Insert(float64(i), "player"+strconv.Itoa(i))
+		}
+	})
+	return benchmarkLeaderboard
+}
+
+func BenchmarkSkipListInsert(b *testing.B) {
+	sl := store.NewSkipList()
+	for i := 0; i < b.N; i++ {
+		sl.Insert(float64(i%1000), "player"+strconv.Itoa(i))
+	}
+}
+
+func BenchmarkSkipListGetRank(b *testing.B) {
+	sl := leaderboard()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n := i % benchmarkLeaderboardSize
+		sl.GetRank(float64(n), "player"+strconv.Itoa(n))
+	}
+}
+
+func BenchmarkSkipListGetRangeLargeOffset(b *testing.B) {
+	sl := leaderboard()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sl.GetRange(benchmarkLeaderboardSize-100, benchmarkLeaderboardSize-91)
+	}
+}
+
+func BenchmarkSkipListGetRevRange(b *testing.B) {
+	sl := leaderboard()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sl.GetRevRange(0, 9)
+	}
+}
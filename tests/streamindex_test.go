@@ -0,0 +1,315 @@
+package tests
+
+import (
+	"math/rand"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	command "github.com/SuchintK/GoDisKV/commands"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+func setupStreamIndexTestClient() *client.Client {
+	conn1, _ := net.Pipe()
+	cli := client.New(conn1)
+	return &cli
+}
+
+// streamIDs returns the IDs of entries in order
+func streamIDs(entries []*store.StreamEntry) []store.StreamID {
+	ids := make([]store.StreamID, len(entries))
+	for i, entry := range entries {
+		ids[i] = entry.ID
+	}
+	return ids
+}
+
+// TestStreamIndexMatchesSlice applies random appends, deletions and trims to a stream and
+// to a plain slice of IDs, checking that seeks over the nodes, both ways, find what a scan
+// of the slice does
+func TestStreamIndexMatchesSlice(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	stream := store.NewStream()
+	var ids []store.StreamID
+
+	next := store.StreamID{Ms: 1}
+	for round := 0; round < 2000; round++ {
+		switch op := rng.Intn(10); {
+		case op < 6:
+			for i := rng.Intn(50); i >= 0; i-- {
+				stream.Append(&store.StreamEntry{ID: next})
+				ids = append(ids, next)
+				if rng.Intn(3) == 0 {
+					next = store.StreamID{Ms: next.Ms + 1}
+				} else {
+					next.Seq++
+				}
+			}
+		case op < 9 && len(ids) > 0:
+			victim := ids[rng.Intn(len(ids))]
+			if !stream.Delete(victim) {
+				t.Fatalf("Delete(%v) found nothing", victim)
+			}
+			for i, id := range ids {
+				if id == victim {
+					ids = append(ids[:i:i], ids[i+1:]...)
+					break
+				}
+			}
+		default:
+			n := rng.Intn(len(ids) + 1)
+			if removed := stream.TrimMaxLen(len(ids)-n, false, 0); removed != n {
+				t.Fatalf("TrimMaxLen removed %d entries, want %d", removed, n)
+			}
+			ids = ids[n:]
+		}
+
+		if stream.Len() != len(ids) {
+			t.Fatalf("Len() = %d, want %d", stream.Len(), len(ids))
+		}
+
+		// Range between two random IDs, including ones that were deleted or never existed
+		start := store.StreamID{Ms: uint64(rng.Int63n(int64(next.Ms + 1))), Seq: uint64(rng.Intn(40))}
+		end := store.StreamID{Ms: start.Ms + uint64(rng.Intn(20)), Seq: uint64(rng.Intn(40))}
+		count := rng.Intn(30)
+
+		want := []store.StreamID{}
+		for _, id := range ids {
+			if id.Compare(start) >= 0 && id.Compare(end) <= 0 && (count == 0 || len(want) < count) {
+				want = append(want, id)
+			}
+		}
+		got := streamIDs(stream.Range(start, end, count))
+		if len(got) != len(want) {
+			t.Fatalf("Range(%v, %v, %d) = %v, want %v", start, end, count, got, want)
+		}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Fatalf("Range(%v, %v, %d) = %v, want %v", start, end, count, got, want)
+			}
+		}
+
+		wantRev := []store.StreamID{}
+		for i := len(ids) - 1; i >= 0; i-- {
+			if ids[i].Compare(start) >= 0 && ids[i].Compare(end) <= 0 && (count == 0 || len(wantRev) < count) {
+				wantRev = append(wantRev, ids[i])
+			}
+		}
+		gotRev := streamIDs(stream.RevRange(start, end, count))
+		if len(gotRev) != len(wantRev) {
+			t.Fatalf("RevRange(%v, %v, %d) = %v, want %v", start, end, count, gotRev, wantRev)
+		}
+		for i := range wantRev {
+			if gotRev[i] != wantRev[i] {
+				t.Fatalf("RevRange(%v, %v, %d) = %v, want %v", start, end, count, gotRev, wantRev)
+			}
+		}
+
+		if len(ids) > 0 {
+			id := ids[rng.Intn(len(ids))]
+			if entry := stream.Lookup(id); entry == nil || entry.ID != id {
+				t.Fatalf("Lookup(%v) = %v", id, entry)
+			}
+		}
+	}
+}
+
+// TestPendingListMatchesSlice adds and removes pending entries in random ID order, checking
+// that scans from a random start visit what a sorted slice of the IDs holds
+func TestPendingListMatchesSlice(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	pel := store.NewPendingList()
+	present := make(map[store.StreamID]bool)
+
+	for round := 0; round < 3000; round++ {
+		id := store.StreamID{Ms: uint64(rng.Intn(500)), Seq: uint64(rng.Intn(4))}
+		if present[id] {
+			if !pel.Remove(id) {
+				t.Fatalf("Remove(%v) found nothing", id)
+			}
+			delete(present, id)
+		} else {
+			pel.Add(&store.PendingEntry{ID: id})
+			present[id] = true
+		}
+
+		ids := make([]store.StreamID, 0, len(present))
+		for id := range present {
+			ids = append(ids, id)
+		}
+		slices.SortFunc(ids, store.StreamID.Compare)
+
+		if pel.Len() != len(ids) {
+			t.Fatalf("Len() = %d, want %d", pel.Len(), len(ids))
+		}
+		if len(ids) > 0 && (pel.First().ID != ids[0] || pel.Last().ID != ids[len(ids)-1]) {
+			t.Fatalf("First/Last = %v/%v, want %v/%v", pel.First().ID, pel.Last().ID, ids[0], ids[len(ids)-1])
+		}
+
+		start := store.StreamID{Ms: uint64(rng.Intn(500)), Seq: uint64(rng.Intn(4))}
+		want := []store.StreamID{}
+		for _, id := range ids {
+			if id.Compare(start) >= 0 {
+				want = append(want, id)
+			}
+		}
+		got := []store.StreamID{}
+		pel.Range(start, func(pending *store.PendingEntry) bool {
+			got = append(got, pending.ID)
+			return true
+		})
+		if !slices.Equal(got, want) {
+			t.Fatalf("Range(%v) = %v, want %v", start, got, want)
+		}
+	}
+
+	// Entries may be removed while they are visited
+	visited := 0
+	pel.Range(store.StreamID{}, func(pending *store.PendingEntry) bool {
+		visited++
+		pel.Remove(pending.ID)
+		return true
+	})
+	if visited != len(present) || pel.Len() != 0 {
+		t.Errorf("Expected to visit and remove %d entries, visited %d and %d remain", len(present), visited, pel.Len())
+	}
+}
+
+func TestStreamApproximateTrimKeepsPartialNodes(t *testing.T) {
+	stream := store.NewStream()
+	for i := 1; i <= 3*store.StreamNodeSize; i++ {
+		stream.Append(&store.StreamEntry{ID: store.StreamID{Ms: uint64(i)}})
+	}
+
+	// Emptying most of the first node leaves it in place, shorter than the others
+	for i := 1; i <= store.StreamNodeSize-10; i++ {
+		stream.Delete(store.StreamID{Ms: uint64(i)})
+	}
+
+	// The first node now holds 10 entries, so removing 50 approximately removes just that node
+	if removed := stream.TrimMaxLen(stream.Len()-50, true, 0); removed != 10 {
+		t.Errorf("Expected the approximate trim to remove 10 entries, removed %d", removed)
+	}
+	if removed := stream.TrimMinID(store.StreamID{Ms: 250}, false, 0); removed != 149 {
+		t.Errorf("Expected the exact trim to remove 149 entries, removed %d", removed)
+	}
+	if first := stream.Entries()[0].ID; first != (store.StreamID{Ms: 250}) {
+		t.Errorf("Expected the stream to start at 250-0, got %v", first)
+	}
+}
+
+func TestXRangeCount(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		expected string
+	}{
+		{
+			name:     "COUNT limits the entries",
+			args:     []string{"log", "-", "+", "COUNT", "2"},
+			expected: "*2\r\n*2\r\n$3\r\n1-0\r\n*2\r\n$1\r\nn\r\n$1\r\n1\r\n*2\r\n$3\r\n2-0\r\n*2\r\n$1\r\nn\r\n$1\r\n2\r\n",
+		},
+		{
+			name:     "COUNT with an exclusive start",
+			args:     []string{"log", "(4-0", "+", "COUNT", "5"},
+			expected: "*1\r\n*2\r\n$3\r\n5-0\r\n*2\r\n$1\r\nn\r\n$1\r\n5\r\n",
+		},
+		{
+			name:     "COUNT 0",
+			args:     []string{"log", "-", "+", "COUNT", "0"},
+			expected: "*0\r\n",
+		},
+		{
+			name:     "Invalid ID",
+			args:     []string{"log", "abc", "+"},
+			expected: "-ERR Invalid stream ID specified as stream command argument\r\n",
+		},
+		{
+			name:     "Unknown option",
+			args:     []string{"log", "-", "+", "LIMIT", "2"},
+			expected: "-syntax error\r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cli := setupStreamIndexTestClient()
+			setupLog(cli, 5)
+
+			result := command.New("xrange", tt.args).Execute(cli)
+			if string(result) != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, string(result))
+			}
+		})
+	}
+}
+
+func TestXReadCount(t *testing.T) {
+	cli := setupStreamIndexTestClient()
+	setupLog(cli, 5)
+
+	expected := "*1\r\n*2\r\n$3\r\nlog\r\n*2\r\n*2\r\n$3\r\n3-0\r\n*2\r\n$1\r\nn\r\n$1\r\n3\r\n*2\r\n$3\r\n4-0\r\n*2\r\n$1\r\nn\r\n$1\r\n4\r\n"
+	if result := command.New("xread", []string{"COUNT", "2", "STREAMS", "log", "2-0"}).Execute(cli); string(result) != expected {
+		t.Errorf("Expected %q, got %q", expected, string(result))
+	}
+}
+
+const benchmarkStreamSize = 1000000
+
+var (
+	benchmarkStream     *store.Stream
+	benchmarkStreamIDs  []string
+	benchmarkStreamOnce sync.Once
+)
+
+// events returns a stream of one million entries, shared by the benchmarks, along with
+// their IDs as strings for the linear scan to parse
+func events() (*store.Stream, []string) {
+	benchmarkStreamOnce.Do(func() {
+		benchmarkStream = store.NewStream()
+		benchmarkStreamIDs = make([]string, benchmarkStreamSize)
+		for i := 0; i < benchmarkStreamSize; i++ {
+			id := store.StreamID{Ms: uint64(1000000 + i/4), Seq: uint64(i % 4)}
+			benchmarkStream.Append(&store.StreamEntry{ID: id, Fields: []store.StreamField{{Name: "n", Value: strconv.Itoa(i)}}})
+			benchmarkStreamIDs[i] = id.String()
+		}
+	})
+	return benchmarkStream, benchmarkStreamIDs
+}
+
+func BenchmarkXRangeIndexed(b *testing.B) {
+	stream, _ := events()
+	store.Set("events", &store.Value{StreamData: stream})
+	defer store.Delete("events")
+	cli := setupStreamIndexTestClient()
+	args := []string{"events", "1200000", "+", "COUNT", "10"}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		command.New("xrange", args).Execute(cli)
+	}
+}
+
+// BenchmarkXRangeLinearScan measures the previous implementation, which split and parsed
+// the ID of every entry on each read
+func BenchmarkXRangeLinearScan(b *testing.B) {
+	_, ids := events()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		found := 0
+		for _, id := range ids {
+			parts := strings.Split(id, "-")
+			ms, _ := strconv.ParseInt(parts[0], 10, 64)
+			strconv.ParseInt(parts[1], 10, 64)
+			if ms >= 1200000 && found < 10 {
+				found++
+			}
+		}
+	}
+}
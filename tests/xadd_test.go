@@ -1,8 +1,10 @@
 package tests
 
 import (
+	"slices"
 	"strings"
 	"testing"
+	"time"
 
 	command "github.com/SuchintK/GoDisKV/commands"
 	"github.com/SuchintK/GoDisKV/store"
@@ -32,45 +34,38 @@ func TestXAddCommand(t *testing.T) {
 				if !exists || val.StreamData == nil {
 					t.Error("Stream should be created")
 				}
-				if len(val.StreamData.Entries) != 1 {
-					t.Errorf("Expected 1 entry, got %d", len(val.StreamData.Entries))
+				if val.StreamData.Len() != 1 {
+					t.Errorf("Expected 1 entry, got %d", val.StreamData.Len())
 				}
 			},
 		},
 		{
 			name: "Add entry with explicit ID",
 			setup: func() {
-				stream := &store.Stream{
-					Entries:       []*store.StreamEntry{},
-					LastTimestamp: 0,
-					LastSequence:  0,
-				}
+				stream := store.NewStream()
 				store.Set("mystream", &store.Value{StreamData: stream})
 			},
 			args:     []string{"mystream", "1000-0", "name", "Alice", "age", "30"},
 			expected: "$6\r\n1000-0\r\n",
 			validate: func(t *testing.T, result string) {
 				val, _ := store.Get("mystream")
-				if len(val.StreamData.Entries) != 1 {
+				if val.StreamData.Len() != 1 {
 					t.Error("Should have 1 entry")
 				}
-				entry := val.StreamData.Entries[0]
-				if entry.Id != "1000-0" {
-					t.Errorf("Expected ID 1000-0, got %s", entry.Id)
+				entry := val.StreamData.Entries()[0]
+				if entry.ID.String() != "1000-0" {
+					t.Errorf("Expected ID 1000-0, got %s", entry.ID)
 				}
-				if entry.Fields["name"] != "Alice" || entry.Fields["age"] != "30" {
-					t.Error("Fields not stored correctly")
+				expected := []store.StreamField{{Name: "name", Value: "Alice"}, {Name: "age", Value: "30"}}
+				if !slices.Equal(entry.Fields, expected) {
+					t.Errorf("Expected fields %v, got %v", expected, entry.Fields)
 				}
 			},
 		},
 		{
 			name: "Add multiple entries with increasing IDs",
 			setup: func() {
-				stream := &store.Stream{
-					Entries:       []*store.StreamEntry{},
-					LastTimestamp: 0,
-					LastSequence:  0,
-				}
+				stream := store.NewStream()
 				store.Set("mystream", &store.Value{StreamData: stream})
 			},
 			args: []string{"mystream", "1000-0", "msg", "first"},
@@ -81,21 +76,17 @@ func TestXAddCommand(t *testing.T) {
 				cmd2.Execute(cli)
 
 				val, _ := store.Get("mystream")
-				if len(val.StreamData.Entries) != 2 {
-					t.Errorf("Expected 2 entries, got %d", len(val.StreamData.Entries))
+				if val.StreamData.Len() != 2 {
+					t.Errorf("Expected 2 entries, got %d", val.StreamData.Len())
 				}
 			},
 		},
 		{
 			name: "Error on duplicate or lower ID",
 			setup: func() {
-				stream := &store.Stream{
-					Entries: []*store.StreamEntry{
-						{Id: "2000-0", Fields: map[string]string{"key": "value"}},
-					},
-					LastTimestamp: 2000,
-					LastSequence:  0,
-				}
+				stream := store.NewStream(
+					&store.StreamEntry{ID: store.StreamID{Ms: 2000}, Fields: []store.StreamField{{Name: "key", Value: "value"}}},
+				)
 				store.Set("mystream", &store.Value{StreamData: stream})
 			},
 			args:     []string{"mystream", "1000-0", "field", "value"},
@@ -104,13 +95,9 @@ func TestXAddCommand(t *testing.T) {
 		{
 			name: "Error on equal ID",
 			setup: func() {
-				stream := &store.Stream{
-					Entries: []*store.StreamEntry{
-						{Id: "1000-0", Fields: map[string]string{"key": "value"}},
-					},
-					LastTimestamp: 1000,
-					LastSequence:  0,
-				}
+				stream := store.NewStream(
+					&store.StreamEntry{ID: store.StreamID{Ms: 1000}, Fields: []store.StreamField{{Name: "key", Value: "value"}}},
+				)
 				store.Set("mystream", &store.Value{StreamData: stream})
 			},
 			args:     []string{"mystream", "1000-0", "field", "value"},
@@ -119,13 +106,9 @@ func TestXAddCommand(t *testing.T) {
 		{
 			name: "Error on same timestamp but lower sequence",
 			setup: func() {
-				stream := &store.Stream{
-					Entries: []*store.StreamEntry{
-						{Id: "1000-5", Fields: map[string]string{"key": "value"}},
-					},
-					LastTimestamp: 1000,
-					LastSequence:  5,
-				}
+				stream := store.NewStream(
+					&store.StreamEntry{ID: store.StreamID{Ms: 1000, Seq: 5}, Fields: []store.StreamField{{Name: "key", Value: "value"}}},
+				)
 				store.Set("mystream", &store.Value{StreamData: stream})
 			},
 			args:     []string{"mystream", "1000-3", "field", "value"},
@@ -134,13 +117,9 @@ func TestXAddCommand(t *testing.T) {
 		{
 			name: "Valid entry with same timestamp but higher sequence",
 			setup: func() {
-				stream := &store.Stream{
-					Entries: []*store.StreamEntry{
-						{Id: "1000-0", Fields: map[string]string{"key": "value1"}},
-					},
-					LastTimestamp: 1000,
-					LastSequence:  0,
-				}
+				stream := store.NewStream(
+					&store.StreamEntry{ID: store.StreamID{Ms: 1000}, Fields: []store.StreamField{{Name: "key", Value: "value1"}}},
+				)
 				store.Set("mystream", &store.Value{StreamData: stream})
 			},
 			args:     []string{"mystream", "1000-1", "field", "value2"},
@@ -149,11 +128,7 @@ func TestXAddCommand(t *testing.T) {
 		{
 			name: "Error on invalid ID format - missing dash",
 			setup: func() {
-				stream := &store.Stream{
-					Entries:       []*store.StreamEntry{},
-					LastTimestamp: 0,
-					LastSequence:  0,
-				}
+				stream := store.NewStream()
 				store.Set("mystream", &store.Value{StreamData: stream})
 			},
 			args:     []string{"mystream", "1000", "field", "value"},
@@ -162,11 +137,7 @@ func TestXAddCommand(t *testing.T) {
 		{
 			name: "Error on invalid ID format - non-numeric timestamp",
 			setup: func() {
-				stream := &store.Stream{
-					Entries:       []*store.StreamEntry{},
-					LastTimestamp: 0,
-					LastSequence:  0,
-				}
+				stream := store.NewStream()
 				store.Set("mystream", &store.Value{StreamData: stream})
 			},
 			args:     []string{"mystream", "abc-0", "field", "value"},
@@ -175,11 +146,7 @@ func TestXAddCommand(t *testing.T) {
 		{
 			name: "Error on invalid ID format - non-numeric sequence",
 			setup: func() {
-				stream := &store.Stream{
-					Entries:       []*store.StreamEntry{},
-					LastTimestamp: 0,
-					LastSequence:  0,
-				}
+				stream := store.NewStream()
 				store.Set("mystream", &store.Value{StreamData: stream})
 			},
 			args:     []string{"mystream", "1000-xyz", "field", "value"},
@@ -197,11 +164,114 @@ func TestXAddCommand(t *testing.T) {
 				if !exists {
 					t.Error("Stream should be created")
 				}
-				if len(val.StreamData.Entries) != 1 {
+				if val.StreamData.Len() != 1 {
 					t.Error("Should have 1 entry")
 				}
 			},
 		},
+		{
+			name: "Partial ID on an empty stream",
+			setup: func() {
+				store.Delete("mystream")
+			},
+			args:     []string{"mystream", "1000-*", "field", "value"},
+			expected: "$6\r\n1000-0\r\n",
+		},
+		{
+			name: "Partial ID continues the sequence of the last millisecond",
+			setup: func() {
+				stream := store.NewStream(
+					&store.StreamEntry{ID: store.StreamID{Ms: 1000, Seq: 5}, Fields: []store.StreamField{{Name: "key", Value: "value"}}},
+				)
+				store.Set("mystream", &store.Value{StreamData: stream})
+			},
+			args:     []string{"mystream", "1000-*", "field", "value"},
+			expected: "$6\r\n1000-6\r\n",
+		},
+		{
+			name: "Partial ID with a later millisecond starts at sequence 0",
+			setup: func() {
+				stream := store.NewStream(
+					&store.StreamEntry{ID: store.StreamID{Ms: 1000, Seq: 5}, Fields: []store.StreamField{{Name: "key", Value: "value"}}},
+				)
+				store.Set("mystream", &store.Value{StreamData: stream})
+			},
+			args:     []string{"mystream", "2000-*", "field", "value"},
+			expected: "$6\r\n2000-0\r\n",
+		},
+		{
+			name: "Error on partial ID with an earlier millisecond",
+			setup: func() {
+				stream := store.NewStream(
+					&store.StreamEntry{ID: store.StreamID{Ms: 1000, Seq: 5}, Fields: []store.StreamField{{Name: "key", Value: "value"}}},
+				)
+				store.Set("mystream", &store.Value{StreamData: stream})
+			},
+			args:     []string{"mystream", "999-*", "field", "value"},
+			expected: "-ERR The ID specified in XADD is equal or smaller than the target stream top item\r\n",
+		},
+		{
+			name: "Partial ID 0-* starts at 0-1",
+			setup: func() {
+				store.Delete("mystream")
+			},
+			args:     []string{"mystream", "0-*", "field", "value"},
+			expected: "$3\r\n0-1\r\n",
+		},
+		{
+			name: "Error on 0-0 on an empty stream",
+			setup: func() {
+				store.Delete("mystream")
+			},
+			args:     []string{"mystream", "0-0", "field", "value"},
+			expected: "-ERR The ID specified in XADD must be greater than 0-0\r\n",
+			validate: func(t *testing.T, result string) {
+				if _, exists := store.Get("mystream"); exists {
+					t.Error("A rejected entry should not create the stream")
+				}
+			},
+		},
+		{
+			name: "Error on invalid partial ID",
+			setup: func() {
+				store.Delete("mystream")
+			},
+			args:     []string{"mystream", "*-1", "field", "value"},
+			expected: "-ERR Invalid stream ID specified as stream command argument\r\n",
+		},
+		{
+			name: "Auto-generated ID continues a last ID ahead of the clock",
+			setup: func() {
+				stream := store.NewStream(
+					&store.StreamEntry{ID: store.StreamID{Ms: 99999999999999, Seq: 3}, Fields: []store.StreamField{{Name: "key", Value: "value"}}},
+				)
+				store.Set("mystream", &store.Value{StreamData: stream})
+			},
+			args:     []string{"mystream", "*", "field", "value"},
+			expected: "$16\r\n99999999999999-4\r\n",
+		},
+		{
+			name: "Last ID is recorded",
+			setup: func() {
+				store.Delete("mystream")
+			},
+			args: []string{"mystream", "1500-7", "field", "value"},
+			validate: func(t *testing.T, result string) {
+				val, _ := store.Get("mystream")
+				if val.StreamData.LastID != (store.StreamID{Ms: 1500, Seq: 7}) {
+					t.Errorf("Expected last ID 1500-7, got %v", val.StreamData.LastID)
+				}
+			},
+		},
+		{
+			name: "Last ID outlives the entries trimmed away",
+			setup: func() {
+				store.Delete("mystream")
+				command.New("xadd", []string{"mystream", "MAXLEN", "0", "1500-7", "field", "value"}).Execute(setupTestClient())
+			},
+			args:     []string{"mystream", "1500-*", "field", "value"},
+			expected: "$6\r\n1500-8\r\n",
+		},
 		{
 			name: "Error on wrong number of arguments - missing field-value pair",
 			setup: func() {
@@ -219,22 +289,34 @@ func TestXAddCommand(t *testing.T) {
 		{
 			name: "Multiple field-value pairs",
 			setup: func() {
-				stream := &store.Stream{
-					Entries:       []*store.StreamEntry{},
-					LastTimestamp: 0,
-					LastSequence:  0,
-				}
+				stream := store.NewStream()
 				store.Set("mystream", &store.Value{StreamData: stream})
 			},
 			args: []string{"mystream", "1000-0", "name", "Bob", "age", "25", "city", "NYC"},
 			validate: func(t *testing.T, result string) {
 				val, _ := store.Get("mystream")
-				entry := val.StreamData.Entries[0]
+				entry := val.StreamData.Entries()[0]
 				if len(entry.Fields) != 3 {
 					t.Errorf("Expected 3 fields, got %d", len(entry.Fields))
 				}
-				if entry.Fields["name"] != "Bob" || entry.Fields["age"] != "25" || entry.Fields["city"] != "NYC" {
-					t.Error("Fields not stored correctly")
+				expected := []store.StreamField{{Name: "name", Value: "Bob"}, {Name: "age", Value: "25"}, {Name: "city", Value: "NYC"}}
+				if !slices.Equal(entry.Fields, expected) {
+					t.Errorf("Expected fields in order %v, got %v", expected, entry.Fields)
+				}
+			},
+		},
+		{
+			name: "Duplicate field names are kept",
+			setup: func() {
+				store.Delete("mystream")
+			},
+			args: []string{"mystream", "1000-0", "tag", "a", "tag", "b"},
+			validate: func(t *testing.T, result string) {
+				val, _ := store.Get("mystream")
+				entry := val.StreamData.Entries()[0]
+				expected := []store.StreamField{{Name: "tag", Value: "a"}, {Name: "tag", Value: "b"}}
+				if !slices.Equal(entry.Fields, expected) {
+					t.Errorf("Expected fields %v, got %v", expected, entry.Fields)
 				}
 			},
 		},
@@ -257,3 +339,51 @@ func TestXAddCommand(t *testing.T) {
 		})
 	}
 }
+
+func TestStreamFieldOrder(t *testing.T) {
+	store.Delete("mystream")
+	cli := setupTestClient()
+	command.New("xadd", []string{"mystream", "1000-0", "zeta", "1", "alpha", "2", "mid", "3", "alpha", "4"}).Execute(cli)
+
+	fields := "*8\r\n$4\r\nzeta\r\n$1\r\n1\r\n$5\r\nalpha\r\n$1\r\n2\r\n$3\r\nmid\r\n$1\r\n3\r\n$5\r\nalpha\r\n$1\r\n4\r\n"
+	entry := "*2\r\n$6\r\n1000-0\r\n" + fields
+
+	tests := []struct {
+		name     string
+		label    string
+		args     []string
+		expected string
+	}{
+		{name: "XRANGE", label: "xrange", args: []string{"mystream", "-", "+"}, expected: "*1\r\n" + entry},
+		{name: "XREAD", label: "xread", args: []string{"streams", "mystream", "0"}, expected: "*1\r\n*2\r\n$8\r\nmystream\r\n*1\r\n" + entry},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Map iteration order is random, so repeat to make sure the order is stable
+			for i := 0; i < 20; i++ {
+				result := command.New(tt.label, tt.args).Execute(cli)
+				if string(result) != tt.expected {
+					t.Fatalf("Expected %q, got %q", tt.expected, string(result))
+				}
+			}
+		})
+	}
+}
+
+func TestXReadDollarAfterXAdd(t *testing.T) {
+	store.Delete("mystream")
+	cli := setupTestClient()
+	command.New("xadd", []string{"mystream", "1000-0", "field", "old"}).Execute(cli)
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		command.New("xadd", []string{"mystream", "2000-0", "field", "new"}).Execute(setupTestClient())
+	}()
+
+	// $ is the last ID set by XADD, so only the entry added while blocked is returned
+	result := string(command.New("xread", []string{"block", "1000", "streams", "mystream", "$"}).Execute(cli))
+	if !strings.Contains(result, "2000-0") || strings.Contains(result, "1000-0") {
+		t.Errorf("Expected only the entry added after the call, got %q", result)
+	}
+}
@@ -0,0 +1,194 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+
+	command "github.com/SuchintK/GoDisKV/commands"
+)
+
+// setupAbandonedEntries delivers every entry of "events" to alice, who never acknowledges them
+func setupAbandonedEntries() {
+	cli := setupXGroupTestClient()
+	setupConsumerGroupStream(cli)
+	command.New("xreadgroup", []string{"group", "workers", "alice", "streams", "events", ">"}).Execute(cli)
+}
+
+func TestXClaimCommand(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		expected string
+		pending  string // extended XPENDING reply after the claim, when set
+	}{
+		{
+			name:     "Claim an idle entry",
+			args:     []string{"events", "workers", "bob", "0", "2-0"},
+			expected: "*1\r\n*2\r\n$3\r\n2-0\r\n*2\r\n$1\r\nn\r\n$1\r\n2\r\n",
+			pending:  "*1\r\n*4\r\n$3\r\n2-0\r\n$3\r\nbob\r\n:0\r\n:2\r\n",
+		},
+		{
+			name:     "Entries not idle long enough stay with their owner",
+			args:     []string{"events", "workers", "bob", "60000", "1-0", "2-0"},
+			expected: "*0\r\n",
+			pending:  "*0\r\n",
+		},
+		{
+			name:     "JUSTID does not count a delivery",
+			args:     []string{"events", "workers", "bob", "0", "1-0", "3-0", "JUSTID"},
+			expected: "*2\r\n$3\r\n1-0\r\n$3\r\n3-0\r\n",
+			pending:  "*2\r\n*4\r\n$3\r\n1-0\r\n$3\r\nbob\r\n:0\r\n:1\r\n*4\r\n$3\r\n3-0\r\n$3\r\nbob\r\n:0\r\n:1\r\n",
+		},
+		{
+			name:     "Entries that are not pending need FORCE",
+			args:     []string{"events", "workers", "bob", "0", "9-0"},
+			expected: "*0\r\n",
+		},
+		{
+			name:     "Invalid min-idle-time",
+			args:     []string{"events", "workers", "bob", "soon", "1-0"},
+			expected: "-ERR Invalid min-idle-time argument for XCLAIM\r\n",
+		},
+		{
+			name:     "Unknown option",
+			args:     []string{"events", "workers", "bob", "0", "1-0", "SOMETIME"},
+			expected: "-ERR Unrecognized XCLAIM option 'SOMETIME'\r\n",
+		},
+		{
+			name:     "Missing group",
+			args:     []string{"events", "nobody", "bob", "0", "1-0"},
+			expected: "-NOGROUP No such consumer group 'nobody' for key name 'events'\r\n",
+		},
+		{
+			name:     "Error on wrong number of arguments",
+			args:     []string{"events", "workers", "bob", "0"},
+			expected: "-wrong number of arguments\r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setupAbandonedEntries()
+			cli := setupXGroupTestClient()
+
+			result := command.New("xclaim", tt.args).Execute(cli)
+			if string(result) != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, string(result))
+			}
+
+			if tt.pending != "" {
+				pending := command.New("xpending", []string{"events", "workers", "-", "+", "10", "bob"}).Execute(cli)
+				if string(pending) != tt.pending {
+					t.Errorf("Expected pending entries %q, got %q", tt.pending, string(pending))
+				}
+			}
+		})
+	}
+}
+
+func TestXClaimIdleAndRetryCount(t *testing.T) {
+	setupAbandonedEntries()
+	cli := setupXGroupTestClient()
+
+	command.New("xclaim", []string{"events", "workers", "bob", "0", "1-0", "IDLE", "5000", "RETRYCOUNT", "7", "JUSTID"}).Execute(cli)
+
+	// The claimed entry counts as idle for five seconds already
+	pending := string(command.New("xpending", []string{"events", "workers", "IDLE", "5000", "-", "+", "10"}).Execute(cli))
+	if !strings.HasPrefix(pending, "*1\r\n*4\r\n$3\r\n1-0\r\n$3\r\nbob\r\n:5") || !strings.HasSuffix(pending, ":7\r\n") {
+		t.Errorf("Expected 1-0 owned by bob with 7 deliveries, got %q", pending)
+	}
+}
+
+func TestXClaimForce(t *testing.T) {
+	cli := setupXGroupTestClient()
+	setupConsumerGroupStream(cli)
+
+	result := command.New("xclaim", []string{"events", "workers", "bob", "0", "2-0", "9-0", "FORCE", "JUSTID"}).Execute(cli)
+	if expected := "*1\r\n$3\r\n2-0\r\n"; string(result) != expected {
+		t.Errorf("Expected %q, got %q", expected, string(result))
+	}
+}
+
+func TestXClaimDropsDeletedEntries(t *testing.T) {
+	setupAbandonedEntries()
+	cli := setupXGroupTestClient()
+
+	command.New("xdel", []string{"events", "1-0"}).Execute(cli)
+
+	result := command.New("xclaim", []string{"events", "workers", "bob", "0", "1-0"}).Execute(cli)
+	if expected := "*0\r\n"; string(result) != expected {
+		t.Errorf("Expected %q, got %q", expected, string(result))
+	}
+
+	summary := command.New("xpending", []string{"events", "workers"}).Execute(cli)
+	if expected := "*4\r\n:2\r\n$3\r\n2-0\r\n$3\r\n3-0\r\n*1\r\n*2\r\n$5\r\nalice\r\n$1\r\n2\r\n"; string(summary) != expected {
+		t.Errorf("Expected %q, got %q", expected, string(summary))
+	}
+}
+
+func TestXAutoClaimCommand(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		expected string
+	}{
+		{
+			name:     "Claim every idle entry",
+			args:     []string{"events", "workers", "bob", "0", "0-0", "JUSTID"},
+			expected: "*3\r\n$3\r\n0-0\r\n*3\r\n$3\r\n1-0\r\n$3\r\n2-0\r\n$3\r\n3-0\r\n*0\r\n",
+		},
+		{
+			name:     "COUNT returns a cursor to resume from",
+			args:     []string{"events", "workers", "bob", "0", "-", "COUNT", "1"},
+			expected: "*3\r\n$3\r\n2-0\r\n*1\r\n*2\r\n$3\r\n1-0\r\n*2\r\n$1\r\nn\r\n$1\r\n1\r\n*0\r\n",
+		},
+		{
+			name:     "Resume from a cursor",
+			args:     []string{"events", "workers", "bob", "0", "2-0", "COUNT", "1", "JUSTID"},
+			expected: "*3\r\n$3\r\n3-0\r\n*1\r\n$3\r\n2-0\r\n*0\r\n",
+		},
+		{
+			name:     "Entries not idle long enough are skipped",
+			args:     []string{"events", "workers", "bob", "60000", "0-0"},
+			expected: "*3\r\n$3\r\n0-0\r\n*0\r\n*0\r\n",
+		},
+		{
+			name:     "Invalid COUNT",
+			args:     []string{"events", "workers", "bob", "0", "0-0", "COUNT", "0"},
+			expected: "-ERR COUNT must be > 0\r\n",
+		},
+		{
+			name:     "Missing group",
+			args:     []string{"events", "nobody", "bob", "0", "0-0"},
+			expected: "-NOGROUP No such consumer group 'nobody' for key name 'events'\r\n",
+		},
+		{
+			name:     "Error on wrong number of arguments",
+			args:     []string{"events", "workers", "bob", "0"},
+			expected: "-wrong number of arguments\r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setupAbandonedEntries()
+
+			result := command.New("xautoclaim", tt.args).Execute(setupXGroupTestClient())
+			if string(result) != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, string(result))
+			}
+		})
+	}
+}
+
+func TestXAutoClaimReportsDeletedEntries(t *testing.T) {
+	setupAbandonedEntries()
+	cli := setupXGroupTestClient()
+
+	command.New("xdel", []string{"events", "3-0"}).Execute(cli)
+
+	result := command.New("xautoclaim", []string{"events", "workers", "bob", "0", "0-0", "JUSTID"}).Execute(cli)
+	if expected := "*3\r\n$3\r\n0-0\r\n*2\r\n$3\r\n1-0\r\n$3\r\n2-0\r\n*1\r\n$3\r\n3-0\r\n"; string(result) != expected {
+		t.Errorf("Expected %q, got %q", expected, string(result))
+	}
+}
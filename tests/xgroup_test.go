@@ -0,0 +1,315 @@
+package tests
+
+import (
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	command "github.com/SuchintK/GoDisKV/commands"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+func setupXGroupTestClient() *client.Client {
+	conn1, _ := net.Pipe()
+	cli := client.New(conn1)
+	return &cli
+}
+
+// setupConsumerGroupStream creates the stream "events" holding three entries and the group
+// "workers" that has not been delivered any of them yet
+func setupConsumerGroupStream(cli *client.Client) {
+	store.Delete("events")
+	command.New("xadd", []string{"events", "1-0", "n", "1"}).Execute(cli)
+	command.New("xadd", []string{"events", "2-0", "n", "2"}).Execute(cli)
+	command.New("xadd", []string{"events", "3-0", "n", "3"}).Execute(cli)
+	command.New("xgroup", []string{"create", "events", "workers", "0"}).Execute(cli)
+}
+
+func TestXGroupCommand(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    [][]string
+		args     []string
+		expected string
+	}{
+		{
+			name:     "Create an existing group",
+			args:     []string{"create", "events", "workers", "$"},
+			expected: "-BUSYGROUP Consumer Group name already exists\r\n",
+		},
+		{
+			name:     "Create on a missing key",
+			args:     []string{"create", "nostream", "workers", "$"},
+			expected: "-ERR The XGROUP subcommand requires the key to exist. Note that for CREATE you may want to use the MKSTREAM option to create an empty stream automatically.\r\n",
+		},
+		{
+			name:     "Create with MKSTREAM",
+			setup:    [][]string{{"xgroup", "create", "newstream", "workers", "$", "mkstream"}},
+			args:     []string{"createconsumer", "newstream", "workers", "alice"},
+			expected: ":1\r\n",
+		},
+		{
+			name:     "Create with an invalid ID",
+			args:     []string{"create", "events", "others", "abc"},
+			expected: "-ERR Invalid stream ID specified as stream command argument\r\n",
+		},
+		{
+			name:     "Create an existing consumer",
+			setup:    [][]string{{"xgroup", "createconsumer", "events", "workers", "alice"}},
+			args:     []string{"createconsumer", "events", "workers", "alice"},
+			expected: ":0\r\n",
+		},
+		{
+			name: "Delete a consumer returns its pending count",
+			setup: [][]string{
+				{"xreadgroup", "group", "workers", "alice", "count", "2", "streams", "events", ">"},
+			},
+			args:     []string{"delconsumer", "events", "workers", "alice"},
+			expected: ":2\r\n",
+		},
+		{
+			name:     "SETID rewinds the group",
+			setup:    [][]string{{"xreadgroup", "group", "workers", "alice", "streams", "events", ">"}},
+			args:     []string{"setid", "events", "workers", "2"},
+			expected: "+OK\r\n",
+		},
+		{
+			name:     "Destroy a group",
+			args:     []string{"destroy", "events", "workers"},
+			expected: ":1\r\n",
+		},
+		{
+			name:     "Destroy a missing group",
+			args:     []string{"destroy", "events", "nobody"},
+			expected: ":0\r\n",
+		},
+		{
+			name:     "Missing group",
+			args:     []string{"createconsumer", "events", "nobody", "alice"},
+			expected: "-NOGROUP No such consumer group 'nobody' for key name 'events'\r\n",
+		},
+		{
+			name:     "Unknown subcommand",
+			args:     []string{"frobnicate", "events", "workers"},
+			expected: "-ERR unknown subcommand 'frobnicate'\r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cli := setupXGroupTestClient()
+			setupConsumerGroupStream(cli)
+			store.Delete("newstream")
+			for _, step := range tt.setup {
+				command.New(step[0], step[1:]).Execute(cli)
+			}
+
+			result := command.New("xgroup", tt.args).Execute(cli)
+			if string(result) != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, string(result))
+			}
+		})
+	}
+}
+
+func TestXReadGroupCommand(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    [][]string
+		args     []string
+		expected string
+	}{
+		{
+			name:     "Read new entries",
+			args:     []string{"group", "workers", "alice", "count", "2", "streams", "events", ">"},
+			expected: "*1\r\n*2\r\n$6\r\nevents\r\n*2\r\n*2\r\n$3\r\n1-0\r\n*2\r\n$1\r\nn\r\n$1\r\n1\r\n*2\r\n$3\r\n2-0\r\n*2\r\n$1\r\nn\r\n$1\r\n2\r\n",
+		},
+		{
+			name:     "Consumers share the entries of the group",
+			setup:    [][]string{{"xreadgroup", "group", "workers", "alice", "count", "2", "streams", "events", ">"}},
+			args:     []string{"group", "workers", "bob", "streams", "events", ">"},
+			expected: "*1\r\n*2\r\n$6\r\nevents\r\n*1\r\n*2\r\n$3\r\n3-0\r\n*2\r\n$1\r\nn\r\n$1\r\n3\r\n",
+		},
+		{
+			name: "Nothing new",
+			setup: [][]string{
+				{"xreadgroup", "group", "workers", "alice", "streams", "events", ">"},
+			},
+			args:     []string{"group", "workers", "bob", "streams", "events", ">"},
+			expected: "*-1\r\n",
+		},
+		{
+			name: "Read the consumer's pending history",
+			setup: [][]string{
+				{"xreadgroup", "group", "workers", "alice", "count", "2", "streams", "events", ">"},
+				{"xack", "events", "workers", "1-0"},
+			},
+			args:     []string{"group", "workers", "alice", "streams", "events", "0"},
+			expected: "*1\r\n*2\r\n$6\r\nevents\r\n*1\r\n*2\r\n$3\r\n2-0\r\n*2\r\n$1\r\nn\r\n$1\r\n2\r\n",
+		},
+		{
+			name:     "Empty history",
+			args:     []string{"group", "workers", "alice", "streams", "events", "0"},
+			expected: "*1\r\n*2\r\n$6\r\nevents\r\n*0\r\n",
+		},
+		{
+			name:     "Missing group",
+			args:     []string{"group", "nobody", "alice", "streams", "events", ">"},
+			expected: "-NOGROUP No such key 'events' or consumer group 'nobody' in XREADGROUP with GROUP option\r\n",
+		},
+		{
+			name:     "Unbalanced streams",
+			args:     []string{"group", "workers", "alice", "streams", "events", "other", ">"},
+			expected: "-ERR Unbalanced 'xreadgroup' list of streams: for each stream key an ID or '>' must be specified.\r\n",
+		},
+		{
+			name:     "Error on wrong number of arguments",
+			args:     []string{"group", "workers", "alice"},
+			expected: "-wrong number of arguments\r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cli := setupXGroupTestClient()
+			setupConsumerGroupStream(cli)
+			for _, step := range tt.setup {
+				command.New(step[0], step[1:]).Execute(cli)
+			}
+
+			result := command.New("xreadgroup", tt.args).Execute(cli)
+			if string(result) != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, string(result))
+			}
+		})
+	}
+}
+
+func TestXReadGroupNoAck(t *testing.T) {
+	cli := setupXGroupTestClient()
+	setupConsumerGroupStream(cli)
+
+	command.New("xreadgroup", []string{"group", "workers", "alice", "noack", "streams", "events", ">"}).Execute(cli)
+
+	expected := "*4\r\n:0\r\n$-1\r\n$-1\r\n*-1\r\n"
+	if result := command.New("xpending", []string{"events", "workers"}).Execute(cli); string(result) != expected {
+		t.Errorf("Expected %q, got %q", expected, string(result))
+	}
+}
+
+func TestXReadGroupBlock(t *testing.T) {
+	cli := setupXGroupTestClient()
+	setupConsumerGroupStream(cli)
+	command.New("xreadgroup", []string{"group", "workers", "alice", "streams", "events", ">"}).Execute(cli)
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		command.New("xadd", []string{"events", "4-0", "n", "4"}).Execute(setupXGroupTestClient())
+	}()
+
+	result := string(command.New("xreadgroup", []string{"group", "workers", "bob", "block", "1000", "streams", "events", ">"}).Execute(cli))
+	if !strings.Contains(result, "4-0") {
+		t.Errorf("Expected the blocked read to return 4-0, got %q", result)
+	}
+
+	start := time.Now()
+	result = string(command.New("xreadgroup", []string{"group", "workers", "bob", "block", "50", "streams", "events", ">"}).Execute(cli))
+	if result != "*-1\r\n" || time.Since(start) < 50*time.Millisecond {
+		t.Errorf("Expected a null array after the timeout, got %q", result)
+	}
+}
+
+func TestXAckCommand(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		expected string
+	}{
+		{name: "Acknowledge pending entries", args: []string{"events", "workers", "1-0", "2-0"}, expected: ":2\r\n"},
+		{name: "Entries not pending are ignored", args: []string{"events", "workers", "1-0", "3-0", "9-0"}, expected: ":1\r\n"},
+		{name: "Missing group", args: []string{"events", "nobody", "1-0"}, expected: ":0\r\n"},
+		{name: "Missing key", args: []string{"nostream", "workers", "1-0"}, expected: ":0\r\n"},
+		{name: "Invalid ID", args: []string{"events", "workers", "abc"}, expected: "-ERR Invalid stream ID specified as stream command argument\r\n"},
+		{name: "Error on wrong number of arguments", args: []string{"events", "workers"}, expected: "-wrong number of arguments\r\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cli := setupXGroupTestClient()
+			setupConsumerGroupStream(cli)
+			command.New("xreadgroup", []string{"group", "workers", "alice", "count", "2", "streams", "events", ">"}).Execute(cli)
+
+			result := command.New("xack", tt.args).Execute(cli)
+			if string(result) != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, string(result))
+			}
+		})
+	}
+}
+
+func TestXPendingCommand(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		expected string
+	}{
+		{
+			name:     "Summary",
+			args:     []string{"events", "workers"},
+			expected: "*4\r\n:3\r\n$3\r\n1-0\r\n$3\r\n3-0\r\n*2\r\n*2\r\n$5\r\nalice\r\n$1\r\n2\r\n*2\r\n$3\r\nbob\r\n$1\r\n1\r\n",
+		},
+		{
+			name:     "Extended form for one consumer",
+			args:     []string{"events", "workers", "-", "+", "10", "bob"},
+			expected: "*1\r\n*4\r\n$3\r\n3-0\r\n$3\r\nbob\r\n:0\r\n:1\r\n",
+		},
+		{
+			name:     "Extended form with exclusive bounds and a count",
+			args:     []string{"events", "workers", "(1-0", "+", "1"},
+			expected: "*1\r\n*4\r\n$3\r\n2-0\r\n$5\r\nalice\r\n:0\r\n:1\r\n",
+		},
+		{
+			name:     "Extended form filtered by idle time",
+			args:     []string{"events", "workers", "IDLE", "60000", "-", "+", "10"},
+			expected: "*0\r\n",
+		},
+		{
+			name:     "Missing group",
+			args:     []string{"events", "nobody"},
+			expected: "-NOGROUP No such key 'events' or consumer group 'nobody'\r\n",
+		},
+		{
+			name:     "Syntax error",
+			args:     []string{"events", "workers", "-", "+"},
+			expected: "-syntax error\r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cli := setupXGroupTestClient()
+			setupConsumerGroupStream(cli)
+			command.New("xreadgroup", []string{"group", "workers", "alice", "count", "2", "streams", "events", ">"}).Execute(cli)
+			command.New("xreadgroup", []string{"group", "workers", "bob", "streams", "events", ">"}).Execute(cli)
+
+			result := command.New("xpending", tt.args).Execute(cli)
+			if string(result) != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, string(result))
+			}
+		})
+	}
+}
+
+func TestXPendingCountsRedeliveries(t *testing.T) {
+	cli := setupXGroupTestClient()
+	setupConsumerGroupStream(cli)
+	command.New("xreadgroup", []string{"group", "workers", "alice", "count", "1", "streams", "events", ">"}).Execute(cli)
+	command.New("xreadgroup", []string{"group", "workers", "alice", "streams", "events", "0"}).Execute(cli)
+
+	expected := "*1\r\n*4\r\n$3\r\n1-0\r\n$5\r\nalice\r\n:0\r\n:2\r\n"
+	if result := command.New("xpending", []string{"events", "workers", "-", "+", "10"}).Execute(cli); string(result) != expected {
+		t.Errorf("Expected %q, got %q", expected, string(result))
+	}
+}
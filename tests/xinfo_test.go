@@ -0,0 +1,289 @@
+package tests
+
+import (
+	"net"
+	"strings"
+	"testing"
+
+	command "github.com/SuchintK/GoDisKV/commands"
+	"github.com/SuchintK/GoDisKV/resp/client"
+)
+
+func setupXInfoTestClient() *client.Client {
+	conn1, _ := net.Pipe()
+	cli := client.New(conn1)
+	return &cli
+}
+
+// streamEntryReply is the RESP encoding of an entry of the "log" stream built by setupLog
+func streamEntryReply(n string) string {
+	return "*2\r\n$3\r\n" + n + "-0\r\n*2\r\n$1\r\nn\r\n$1\r\n" + n + "\r\n"
+}
+
+func TestXRevRangeCommand(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		expected string
+	}{
+		{
+			name:     "Newest first",
+			args:     []string{"log", "+", "-"},
+			expected: "*5\r\n" + streamEntryReply("5") + streamEntryReply("4") + streamEntryReply("3") + streamEntryReply("2") + streamEntryReply("1"),
+		},
+		{
+			name:     "COUNT",
+			args:     []string{"log", "+", "-", "COUNT", "2"},
+			expected: "*2\r\n" + streamEntryReply("5") + streamEntryReply("4"),
+		},
+		{
+			name:     "Bounded range",
+			args:     []string{"log", "4", "(2-0"},
+			expected: "*2\r\n" + streamEntryReply("4") + streamEntryReply("3"),
+		},
+		{
+			name:     "Empty range",
+			args:     []string{"log", "2", "4"},
+			expected: "*0\r\n",
+		},
+		{
+			name:     "Missing key",
+			args:     []string{"nolog", "+", "-"},
+			expected: "*0\r\n",
+		},
+		{
+			name:     "Error on wrong number of arguments",
+			args:     []string{"log", "+"},
+			expected: "-wrong number of arguments\r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cli := setupXInfoTestClient()
+			setupLog(cli, 5)
+
+			result := command.New("xrevrange", tt.args).Execute(cli)
+			if string(result) != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, string(result))
+			}
+		})
+	}
+}
+
+func TestXRangeWrongType(t *testing.T) {
+	cli := setupXInfoTestClient()
+	command.New("set", []string{"logstring", "value"}).Execute(cli)
+
+	for _, label := range []string{"xrange", "xrevrange"} {
+		result := command.New(label, []string{"logstring", "-", "+"}).Execute(cli)
+		if expected := "-WRONGTYPE Operation against a key holding the wrong kind of value\r\n"; string(result) != expected {
+			t.Errorf("%s: expected %q, got %q", label, expected, string(result))
+		}
+	}
+}
+
+func TestXInfoStream(t *testing.T) {
+	cli := setupXInfoTestClient()
+	setupLog(cli, 5)
+	command.New("xdel", []string{"log", "3-0"}).Execute(cli)
+	command.New("xgroup", []string{"create", "log", "workers", "0"}).Execute(cli)
+
+	expected := "*16\r\n" +
+		"$6\r\nlength\r\n:4\r\n" +
+		"$17\r\nlast-generated-id\r\n$3\r\n5-0\r\n" +
+		"$20\r\nmax-deleted-entry-id\r\n$3\r\n3-0\r\n" +
+		"$13\r\nentries-added\r\n:5\r\n" +
+		"$23\r\nrecorded-first-entry-id\r\n$3\r\n1-0\r\n" +
+		"$6\r\ngroups\r\n:1\r\n" +
+		"$11\r\nfirst-entry\r\n" + streamEntryReply("1") +
+		"$10\r\nlast-entry\r\n" + streamEntryReply("5")
+	if result := command.New("xinfo", []string{"stream", "log"}).Execute(cli); string(result) != expected {
+		t.Errorf("Expected %q, got %q", expected, string(result))
+	}
+}
+
+func TestXInfoStreamFull(t *testing.T) {
+	cli := setupXInfoTestClient()
+	setupLog(cli, 5)
+	command.New("xgroup", []string{"create", "log", "workers", "0"}).Execute(cli)
+	command.New("xreadgroup", []string{"group", "workers", "alice", "count", "3", "streams", "log", ">"}).Execute(cli)
+
+	result := string(command.New("xinfo", []string{"stream", "log", "full", "count", "2"}).Execute(cli))
+
+	entries := "$7\r\nentries\r\n*2\r\n" + streamEntryReply("1") + streamEntryReply("2")
+	if !strings.Contains(result, entries) {
+		t.Errorf("Expected the first two entries in %q", result)
+	}
+	group := "$4\r\nname\r\n$7\r\nworkers\r\n$17\r\nlast-delivered-id\r\n$3\r\n3-0\r\n$12\r\nentries-read\r\n:3\r\n$3\r\nlag\r\n:2\r\n$9\r\npel-count\r\n:3\r\n$7\r\npending\r\n*2\r\n"
+	if !strings.Contains(result, group) {
+		t.Errorf("Expected the group with two of its pending entries in %q", result)
+	}
+	if !strings.Contains(result, "$4\r\nname\r\n$5\r\nalice\r\n") || !strings.Contains(result, "$9\r\npel-count\r\n:3\r\n$7\r\npending\r\n*2\r\n*3\r\n$3\r\n1-0\r\n") {
+		t.Errorf("Expected alice with two of her pending entries in %q", result)
+	}
+}
+
+func TestXInfoGroupsLag(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    [][]string
+		expected string // entries-read and lag
+	}{
+		{
+			name:     "New group from the start",
+			setup:    [][]string{{"xgroup", "create", "log", "workers", "0"}},
+			expected: "$12\r\nentries-read\r\n$-1\r\n$3\r\nlag\r\n:5\r\n",
+		},
+		{
+			name:     "New group from the end",
+			setup:    [][]string{{"xgroup", "create", "log", "workers", "$"}},
+			expected: "$12\r\nentries-read\r\n$-1\r\n$3\r\nlag\r\n:0\r\n",
+		},
+		{
+			name: "After reading",
+			setup: [][]string{
+				{"xgroup", "create", "log", "workers", "0"},
+				{"xreadgroup", "group", "workers", "alice", "count", "2", "streams", "log", ">"},
+			},
+			expected: "$12\r\nentries-read\r\n:2\r\n$3\r\nlag\r\n:3\r\n",
+		},
+		{
+			name: "Entries deleted before the group do not matter",
+			setup: [][]string{
+				{"xgroup", "create", "log", "workers", "0"},
+				{"xreadgroup", "group", "workers", "alice", "count", "2", "streams", "log", ">"},
+				{"xdel", "log", "1-0"},
+			},
+			expected: "$12\r\nentries-read\r\n:2\r\n$3\r\nlag\r\n:3\r\n",
+		},
+		{
+			name: "Entries deleted ahead of the group make the lag unknown",
+			setup: [][]string{
+				{"xgroup", "create", "log", "workers", "0"},
+				{"xreadgroup", "group", "workers", "alice", "count", "2", "streams", "log", ">"},
+				{"xdel", "log", "4-0"},
+			},
+			expected: "$12\r\nentries-read\r\n:2\r\n$3\r\nlag\r\n$-1\r\n",
+		},
+		{
+			name: "Reading past the deleted entries",
+			setup: [][]string{
+				{"xgroup", "create", "log", "workers", "0"},
+				{"xdel", "log", "4-0"},
+				{"xreadgroup", "group", "workers", "alice", "streams", "log", ">"},
+			},
+			expected: "$12\r\nentries-read\r\n:5\r\n$3\r\nlag\r\n:0\r\n",
+		},
+		{
+			name: "Trimmed entries count as read",
+			setup: [][]string{
+				{"xgroup", "create", "log", "workers", "0"},
+				{"xtrim", "log", "maxlen", "2"},
+			},
+			expected: "$12\r\nentries-read\r\n$-1\r\n$3\r\nlag\r\n:2\r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cli := setupXInfoTestClient()
+			setupLog(cli, 5)
+			for _, step := range tt.setup {
+				command.New(step[0], step[1:]).Execute(cli)
+			}
+
+			result := string(command.New("xinfo", []string{"groups", "log"}).Execute(cli))
+			if !strings.HasPrefix(result, "*1\r\n*12\r\n$4\r\nname\r\n$7\r\nworkers\r\n") || !strings.HasSuffix(result, tt.expected) {
+				t.Errorf("Expected the group to end with %q, got %q", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestXInfoConsumers(t *testing.T) {
+	cli := setupXInfoTestClient()
+	setupLog(cli, 5)
+	command.New("xgroup", []string{"create", "log", "workers", "0"}).Execute(cli)
+	command.New("xreadgroup", []string{"group", "workers", "alice", "count", "2", "streams", "log", ">"}).Execute(cli)
+	command.New("xgroup", []string{"createconsumer", "log", "workers", "bob"}).Execute(cli)
+
+	result := string(command.New("xinfo", []string{"consumers", "log", "workers"}).Execute(cli))
+	alice := "*2\r\n*6\r\n$4\r\nname\r\n$5\r\nalice\r\n$7\r\npending\r\n:2\r\n$4\r\nidle\r\n:"
+	bob := "*6\r\n$4\r\nname\r\n$3\r\nbob\r\n$7\r\npending\r\n:0\r\n$4\r\nidle\r\n:"
+	if !strings.HasPrefix(result, alice) || !strings.Contains(result, bob) {
+		t.Errorf("Expected alice with 2 pending entries and bob with none, got %q", result)
+	}
+}
+
+func TestXInfoErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		expected string
+	}{
+		{name: "Missing key", args: []string{"stream", "nolog"}, expected: "-ERR no such key\r\n"},
+		{name: "Missing group", args: []string{"consumers", "log", "nobody"}, expected: "-NOGROUP No such consumer group 'nobody' for key name 'log'\r\n"},
+		{name: "Unknown subcommand", args: []string{"keys", "log"}, expected: "-ERR unknown subcommand 'keys'\r\n"},
+		{name: "Bad FULL option", args: []string{"stream", "log", "full", "limit", "2"}, expected: "-syntax error\r\n"},
+		{name: "Error on wrong number of arguments", args: []string{"groups"}, expected: "-wrong number of arguments\r\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cli := setupXInfoTestClient()
+			setupLog(cli, 5)
+
+			result := command.New("xinfo", tt.args).Execute(cli)
+			if string(result) != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, string(result))
+			}
+		})
+	}
+}
+
+func TestXSetIDCommand(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		expected string
+	}{
+		{name: "Move the last ID forward", args: []string{"log", "9000-0"}, expected: "+OK\r\n"},
+		{name: "Set the counters", args: []string{"log", "10-0", "ENTRIESADDED", "100", "MAXDELETEDID", "7-0"}, expected: "+OK\r\n"},
+		{name: "Below the top entry", args: []string{"log", "4-0"}, expected: "-ERR The ID specified in XSETID is smaller than the target stream top item\r\n"},
+		{name: "Fewer entries added than held", args: []string{"log", "10-0", "ENTRIESADDED", "3"}, expected: "-ERR The entries_added specified in XSETID is smaller than the target stream length\r\n"},
+		{name: "Max deleted ID past the last ID", args: []string{"log", "10-0", "MAXDELETEDID", "11-0"}, expected: "-ERR The ID specified in XSETID is smaller than the provided max_deleted_entry_id\r\n"},
+		{name: "Missing key", args: []string{"nolog", "10-0"}, expected: "-ERR no such key\r\n"},
+		{name: "Error on wrong number of arguments", args: []string{"log"}, expected: "-wrong number of arguments\r\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cli := setupXInfoTestClient()
+			setupLog(cli, 5)
+
+			result := command.New("xsetid", tt.args).Execute(cli)
+			if string(result) != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, string(result))
+			}
+		})
+	}
+}
+
+func TestXSetIDRejectsOlderIDs(t *testing.T) {
+	cli := setupXInfoTestClient()
+	setupLog(cli, 5)
+	command.New("xsetid", []string{"log", "9000-0", "ENTRIESADDED", "42", "MAXDELETEDID", "8000-0"}).Execute(cli)
+
+	expected := "-ERR The ID specified in XADD is equal or smaller than the target stream top item\r\n"
+	if result := command.New("xadd", []string{"log", "8999-0", "n", "x"}).Execute(cli); string(result) != expected {
+		t.Errorf("Expected %q, got %q", expected, string(result))
+	}
+
+	info := string(command.New("xinfo", []string{"stream", "log"}).Execute(cli))
+	for _, field := range []string{"$17\r\nlast-generated-id\r\n$6\r\n9000-0\r\n", "$20\r\nmax-deleted-entry-id\r\n$6\r\n8000-0\r\n", "$13\r\nentries-added\r\n:42\r\n"} {
+		if !strings.Contains(info, field) {
+			t.Errorf("Expected %q in %q", field, info)
+		}
+	}
+}
@@ -19,13 +19,11 @@ func TestXRangeCommand(t *testing.T) {
 		{
 			name: "Range query with explicit sequence numbers",
 			setup: func() {
-				stream := &store.Stream{
-					Entries: []*store.StreamEntry{
-						{Id: "1000-0", Fields: map[string]string{"key": "value1"}},
-						{Id: "2000-0", Fields: map[string]string{"key": "value2"}},
-						{Id: "3000-0", Fields: map[string]string{"key": "value3"}},
-					},
-				}
+				stream := store.NewStream(
+					&store.StreamEntry{ID: store.StreamID{Ms: 1000}, Fields: []store.StreamField{{Name: "key", Value: "value1"}}},
+					&store.StreamEntry{ID: store.StreamID{Ms: 2000}, Fields: []store.StreamField{{Name: "key", Value: "value2"}}},
+					&store.StreamEntry{ID: store.StreamID{Ms: 3000}, Fields: []store.StreamField{{Name: "key", Value: "value3"}}},
+				)
 				store.Set("mystream", &store.Value{StreamData: stream})
 			},
 			args: []string{"mystream", "1000-0", "2000-0"},
@@ -41,14 +39,12 @@ func TestXRangeCommand(t *testing.T) {
 		{
 			name: "Range query with missing sequence numbers",
 			setup: func() {
-				stream := &store.Stream{
-					Entries: []*store.StreamEntry{
-						{Id: "1000-0", Fields: map[string]string{"key": "value1"}},
-						{Id: "1000-1", Fields: map[string]string{"key": "value2"}},
-						{Id: "1000-2", Fields: map[string]string{"key": "value3"}},
-						{Id: "2000-0", Fields: map[string]string{"key": "value4"}},
-					},
-				}
+				stream := store.NewStream(
+					&store.StreamEntry{ID: store.StreamID{Ms: 1000}, Fields: []store.StreamField{{Name: "key", Value: "value1"}}},
+					&store.StreamEntry{ID: store.StreamID{Ms: 1000, Seq: 1}, Fields: []store.StreamField{{Name: "key", Value: "value2"}}},
+					&store.StreamEntry{ID: store.StreamID{Ms: 1000, Seq: 2}, Fields: []store.StreamField{{Name: "key", Value: "value3"}}},
+					&store.StreamEntry{ID: store.StreamID{Ms: 2000}, Fields: []store.StreamField{{Name: "key", Value: "value4"}}},
+				)
 				store.Set("mystream", &store.Value{StreamData: stream})
 			},
 			args: []string{"mystream", "1000", "1000"},
@@ -65,12 +61,10 @@ func TestXRangeCommand(t *testing.T) {
 		{
 			name: "Range query with - (minimum)",
 			setup: func() {
-				stream := &store.Stream{
-					Entries: []*store.StreamEntry{
-						{Id: "1000-0", Fields: map[string]string{"key": "value1"}},
-						{Id: "2000-0", Fields: map[string]string{"key": "value2"}},
-					},
-				}
+				stream := store.NewStream(
+					&store.StreamEntry{ID: store.StreamID{Ms: 1000}, Fields: []store.StreamField{{Name: "key", Value: "value1"}}},
+					&store.StreamEntry{ID: store.StreamID{Ms: 2000}, Fields: []store.StreamField{{Name: "key", Value: "value2"}}},
+				)
 				store.Set("mystream", &store.Value{StreamData: stream})
 			},
 			args: []string{"mystream", "-", "1500-0"},
@@ -86,13 +80,11 @@ func TestXRangeCommand(t *testing.T) {
 		{
 			name: "Range query with + (maximum)",
 			setup: func() {
-				stream := &store.Stream{
-					Entries: []*store.StreamEntry{
-						{Id: "1000-0", Fields: map[string]string{"key": "value1"}},
-						{Id: "2000-0", Fields: map[string]string{"key": "value2"}},
-						{Id: "3000-0", Fields: map[string]string{"key": "value3"}},
-					},
-				}
+				stream := store.NewStream(
+					&store.StreamEntry{ID: store.StreamID{Ms: 1000}, Fields: []store.StreamField{{Name: "key", Value: "value1"}}},
+					&store.StreamEntry{ID: store.StreamID{Ms: 2000}, Fields: []store.StreamField{{Name: "key", Value: "value2"}}},
+					&store.StreamEntry{ID: store.StreamID{Ms: 3000}, Fields: []store.StreamField{{Name: "key", Value: "value3"}}},
+				)
 				store.Set("mystream", &store.Value{StreamData: stream})
 			},
 			args: []string{"mystream", "1500-0", "+"},
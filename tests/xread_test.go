@@ -21,12 +21,10 @@ func TestXReadCommand(t *testing.T) {
 		{
 			name: "Read from single stream",
 			setup: func() {
-				stream := &store.Stream{
-					Entries: []*store.StreamEntry{
-						{Id: "1000-0", Fields: map[string]string{"key": "value1"}},
-						{Id: "2000-0", Fields: map[string]string{"key": "value2"}},
-					},
-				}
+				stream := store.NewStream(
+					&store.StreamEntry{ID: store.StreamID{Ms: 1000}, Fields: []store.StreamField{{Name: "key", Value: "value1"}}},
+					&store.StreamEntry{ID: store.StreamID{Ms: 2000}, Fields: []store.StreamField{{Name: "key", Value: "value2"}}},
+				)
 				store.Set("mystream", &store.Value{StreamData: stream})
 			},
 			args: []string{"streams", "mystream", "0-0"},
@@ -42,16 +40,12 @@ func TestXReadCommand(t *testing.T) {
 		{
 			name: "Read from multiple streams",
 			setup: func() {
-				stream1 := &store.Stream{
-					Entries: []*store.StreamEntry{
-						{Id: "1000-0", Fields: map[string]string{"key": "value1"}},
-					},
-				}
-				stream2 := &store.Stream{
-					Entries: []*store.StreamEntry{
-						{Id: "2000-0", Fields: map[string]string{"key": "value2"}},
-					},
-				}
+				stream1 := store.NewStream(
+					&store.StreamEntry{ID: store.StreamID{Ms: 1000}, Fields: []store.StreamField{{Name: "key", Value: "value1"}}},
+				)
+				stream2 := store.NewStream(
+					&store.StreamEntry{ID: store.StreamID{Ms: 2000}, Fields: []store.StreamField{{Name: "key", Value: "value2"}}},
+				)
 				store.Set("stream1", &store.Value{StreamData: stream1})
 				store.Set("stream2", &store.Value{StreamData: stream2})
 			},
@@ -65,19 +59,12 @@ func TestXReadCommand(t *testing.T) {
 		{
 			name: "Blocking read with timeout - receives data",
 			setup: func() {
-				stream := &store.Stream{
-					Entries: []*store.StreamEntry{},
-					LastID:  "0-0",
-				}
+				stream := store.NewStream()
 				store.Set("mystream", &store.Value{StreamData: stream})
 				// Add entry after 100ms
 				go func() {
 					time.Sleep(100 * time.Millisecond)
-					stream.Entries = append(stream.Entries, &store.StreamEntry{
-						Id:     "1000-0",
-						Fields: map[string]string{"key": "value1"},
-					})
-					stream.LastID = "1000-0"
+					command.New("xadd", []string{"mystream", "1000-0", "key", "value1"}).Execute(setupTestClient())
 				}()
 			},
 			args:    []string{"block", "500", "streams", "mystream", "$"},
@@ -91,10 +78,7 @@ func TestXReadCommand(t *testing.T) {
 		{
 			name: "Blocking read timeout - no data",
 			setup: func() {
-				stream := &store.Stream{
-					Entries: []*store.StreamEntry{},
-					LastID:  "0-0",
-				}
+				stream := store.NewStream()
 				store.Set("mystream", &store.Value{StreamData: stream})
 			},
 			args:     []string{"block", "100", "streams", "mystream", "$"},
@@ -104,13 +88,10 @@ func TestXReadCommand(t *testing.T) {
 		{
 			name: "Read with $ special ID",
 			setup: func() {
-				stream := &store.Stream{
-					Entries: []*store.StreamEntry{
-						{Id: "1000-0", Fields: map[string]string{"key": "value1"}},
-						{Id: "2000-0", Fields: map[string]string{"key": "value2"}},
-					},
-					LastID: "2000-0",
-				}
+				stream := store.NewStream(
+					&store.StreamEntry{ID: store.StreamID{Ms: 1000}, Fields: []store.StreamField{{Name: "key", Value: "value1"}}},
+					&store.StreamEntry{ID: store.StreamID{Ms: 2000}, Fields: []store.StreamField{{Name: "key", Value: "value2"}}},
+				)
 				store.Set("mystream", &store.Value{StreamData: stream})
 			},
 			args:     []string{"streams", "mystream", "$"},
@@ -0,0 +1,142 @@
+package tests
+
+import (
+	"net"
+	"strconv"
+	"testing"
+
+	command "github.com/SuchintK/GoDisKV/commands"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+func setupXTrimTestClient() *client.Client {
+	conn1, _ := net.Pipe()
+	cli := client.New(conn1)
+	return &cli
+}
+
+// setupLog creates the stream "log" with entries 1-0 to n-0
+func setupLog(cli *client.Client, n int) {
+	store.Delete("log")
+	for i := 1; i <= n; i++ {
+		command.New("xadd", []string{"log", strconv.Itoa(i) + "-0", "n", strconv.Itoa(i)}).Execute(cli)
+	}
+}
+
+func TestXLenAndXDel(t *testing.T) {
+	tests := []struct {
+		name     string
+		command  string
+		args     []string
+		expected string
+		length   string
+	}{
+		{name: "Length", command: "xlen", args: []string{"log"}, expected: ":5\r\n", length: ":5\r\n"},
+		{name: "Length of a missing key", command: "xlen", args: []string{"nolog"}, expected: ":0\r\n", length: ":5\r\n"},
+		{name: "Delete entries", command: "xdel", args: []string{"log", "2-0", "4-0", "9-0"}, expected: ":2\r\n", length: ":3\r\n"},
+		{name: "Delete from a missing key", command: "xdel", args: []string{"nolog", "1-0"}, expected: ":0\r\n", length: ":5\r\n"},
+		{name: "Invalid ID", command: "xdel", args: []string{"log", "1-0", "abc"}, expected: "-ERR Invalid stream ID specified as stream command argument\r\n", length: ":5\r\n"},
+		{name: "Error on wrong number of arguments", command: "xdel", args: []string{"log"}, expected: "-wrong number of arguments\r\n", length: ":5\r\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cli := setupXTrimTestClient()
+			setupLog(cli, 5)
+
+			result := command.New(tt.command, tt.args).Execute(cli)
+			if string(result) != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, string(result))
+			}
+			if length := command.New("xlen", []string{"log"}).Execute(cli); string(length) != tt.length {
+				t.Errorf("Expected length %q, got %q", tt.length, string(length))
+			}
+		})
+	}
+}
+
+func TestXTrimCommand(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		expected string
+		length   string
+	}{
+		{name: "MAXLEN", args: []string{"log", "MAXLEN", "100"}, expected: ":150\r\n", length: ":100\r\n"},
+		{name: "Exact MAXLEN", args: []string{"log", "MAXLEN", "=", "240"}, expected: ":10\r\n", length: ":240\r\n"},
+		{name: "Approximate MAXLEN removes whole nodes", args: []string{"log", "MAXLEN", "~", "120"}, expected: ":100\r\n", length: ":150\r\n"},
+		{name: "Approximate MAXLEN below a node", args: []string{"log", "MAXLEN", "~", "200"}, expected: ":0\r\n", length: ":250\r\n"},
+		{name: "MAXLEN larger than the stream", args: []string{"log", "MAXLEN", "1000"}, expected: ":0\r\n", length: ":250\r\n"},
+		{name: "MINID", args: []string{"log", "MINID", "201"}, expected: ":200\r\n", length: ":50\r\n"},
+		{name: "Approximate MINID with LIMIT", args: []string{"log", "MINID", "~", "250", "LIMIT", "100"}, expected: ":100\r\n", length: ":150\r\n"},
+		{name: "LIMIT below a node trims nothing", args: []string{"log", "MAXLEN", "~", "0", "LIMIT", "50"}, expected: ":0\r\n", length: ":250\r\n"},
+		{name: "Missing key", args: []string{"nolog", "MAXLEN", "0"}, expected: ":0\r\n", length: ":250\r\n"},
+		{name: "LIMIT needs ~", args: []string{"log", "MAXLEN", "10", "LIMIT", "5"}, expected: "-ERR syntax error, LIMIT cannot be used without the special ~ option\r\n", length: ":250\r\n"},
+		{name: "Negative MAXLEN", args: []string{"log", "MAXLEN", "-1"}, expected: "-ERR The MAXLEN argument must be >= 0.\r\n", length: ":250\r\n"},
+		{name: "Both strategies", args: []string{"log", "MAXLEN", "10", "MINID", "5"}, expected: "-ERR syntax error, MAXLEN and MINID options at the same time are not compatible\r\n", length: ":250\r\n"},
+		{name: "Unknown strategy", args: []string{"log", "MAXSIZE", "10"}, expected: "-syntax error\r\n", length: ":250\r\n"},
+		{name: "Error on wrong number of arguments", args: []string{"log", "MAXLEN"}, expected: "-wrong number of arguments\r\n", length: ":250\r\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cli := setupXTrimTestClient()
+			setupLog(cli, 250)
+
+			result := command.New("xtrim", tt.args).Execute(cli)
+			if string(result) != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, string(result))
+			}
+			if length := command.New("xlen", []string{"log"}).Execute(cli); string(length) != tt.length {
+				t.Errorf("Expected length %q, got %q", tt.length, string(length))
+			}
+		})
+	}
+}
+
+func TestXAddTrimming(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		expected string
+		length   string
+	}{
+		{name: "MAXLEN", args: []string{"log", "MAXLEN", "3", "6-0", "n", "6"}, expected: "$3\r\n6-0\r\n", length: ":3\r\n"},
+		{name: "MINID", args: []string{"log", "MINID", "=", "5", "6-0", "n", "6"}, expected: "$3\r\n6-0\r\n", length: ":2\r\n"},
+		{name: "NOMKSTREAM on an existing stream", args: []string{"log", "NOMKSTREAM", "MAXLEN", "~", "1", "6-0", "n", "6"}, expected: "$3\r\n6-0\r\n", length: ":6\r\n"},
+		{name: "NOMKSTREAM on a missing key", args: []string{"nolog", "NOMKSTREAM", "*", "n", "1"}, expected: "$-1\r\n", length: ":5\r\n"},
+		{name: "Invalid threshold", args: []string{"log", "MINID", "abc", "6-0", "n", "6"}, expected: "-ERR Invalid stream ID specified as stream command argument\r\n", length: ":5\r\n"},
+		{name: "Fields are required", args: []string{"log", "MAXLEN", "3", "6-0"}, expected: "-wrong number of arguments\r\n", length: ":5\r\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cli := setupXTrimTestClient()
+			setupLog(cli, 5)
+			store.Delete("nolog")
+
+			result := command.New("xadd", tt.args).Execute(cli)
+			if string(result) != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, string(result))
+			}
+			if length := command.New("xlen", []string{"log"}).Execute(cli); string(length) != tt.length {
+				t.Errorf("Expected length %q, got %q", tt.length, string(length))
+			}
+			if _, exists := store.Get("nolog"); exists {
+				t.Error("NOMKSTREAM should not create the stream")
+			}
+		})
+	}
+}
+
+func TestXAddAfterDeletingEveryEntry(t *testing.T) {
+	cli := setupXTrimTestClient()
+	setupLog(cli, 3)
+	command.New("xtrim", []string{"log", "MAXLEN", "0"}).Execute(cli)
+
+	expected := "-ERR The ID specified in XADD is equal or smaller than the target stream top item\r\n"
+	if result := command.New("xadd", []string{"log", "2-0", "n", "2"}).Execute(cli); string(result) != expected {
+		t.Errorf("Expected %q, got %q", expected, string(result))
+	}
+}
@@ -0,0 +1,178 @@
+package tests
+
+import (
+	"net"
+	"testing"
+
+	command "github.com/SuchintK/GoDisKV/commands"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+func setupZIncrByTestClient() *client.Client {
+	conn1, _ := net.Pipe()
+	cli := client.New(conn1)
+	return &cli
+}
+
+// TestZAddFlags runs each command against a board holding alice=10 and bob=20
+func TestZAddFlags(t *testing.T) {
+	tests := []struct {
+		name     string
+		command  string
+		args     []string
+		expected string
+		scores   string // ZRANGE board 0 -1 WITHSCORES after the command
+	}{
+		{
+			name:     "NX only adds new members",
+			command:  "zadd",
+			args:     []string{"board", "nx", "99", "alice", "30", "carol"},
+			expected: ":1\r\n",
+			scores:   "*6\r\n$5\r\nalice\r\n$2\r\n10\r\n$3\r\nbob\r\n$2\r\n20\r\n$5\r\ncarol\r\n$2\r\n30\r\n",
+		},
+		{
+			name:     "XX only updates existing members",
+			command:  "zadd",
+			args:     []string{"board", "xx", "5", "alice", "30", "carol"},
+			expected: ":0\r\n",
+			scores:   "*4\r\n$5\r\nalice\r\n$1\r\n5\r\n$3\r\nbob\r\n$2\r\n20\r\n",
+		},
+		{
+			name:     "GT only raises scores but still adds",
+			command:  "zadd",
+			args:     []string{"board", "gt", "ch", "5", "alice", "25", "bob", "1", "carol"},
+			expected: ":2\r\n",
+			scores:   "*6\r\n$5\r\ncarol\r\n$1\r\n1\r\n$5\r\nalice\r\n$2\r\n10\r\n$3\r\nbob\r\n$2\r\n25\r\n",
+		},
+		{
+			name:     "LT only lowers scores",
+			command:  "zadd",
+			args:     []string{"board", "lt", "ch", "5", "alice", "25", "bob"},
+			expected: ":1\r\n",
+			scores:   "*4\r\n$5\r\nalice\r\n$1\r\n5\r\n$3\r\nbob\r\n$2\r\n20\r\n",
+		},
+		{
+			name:     "CH counts updated members",
+			command:  "zadd",
+			args:     []string{"board", "ch", "11", "alice", "20", "bob", "1", "carol"},
+			expected: ":2\r\n",
+			scores:   "*6\r\n$5\r\ncarol\r\n$1\r\n1\r\n$5\r\nalice\r\n$2\r\n11\r\n$3\r\nbob\r\n$2\r\n20\r\n",
+		},
+		{
+			name:     "INCR returns the new score",
+			command:  "zadd",
+			args:     []string{"board", "incr", "2.5", "alice"},
+			expected: "$4\r\n12.5\r\n",
+			scores:   "*4\r\n$5\r\nalice\r\n$4\r\n12.5\r\n$3\r\nbob\r\n$2\r\n20\r\n",
+		},
+		{
+			name:     "INCR blocked by GT returns null",
+			command:  "zadd",
+			args:     []string{"board", "gt", "incr", "-1", "alice"},
+			expected: "$-1\r\n",
+			scores:   "*4\r\n$5\r\nalice\r\n$2\r\n10\r\n$3\r\nbob\r\n$2\r\n20\r\n",
+		},
+		{
+			name:     "Infinite scores are accepted",
+			command:  "zadd",
+			args:     []string{"board", "+inf", "max", "-inf", "min"},
+			expected: ":2\r\n",
+			scores:   "*8\r\n$3\r\nmin\r\n$4\r\n-inf\r\n$5\r\nalice\r\n$2\r\n10\r\n$3\r\nbob\r\n$2\r\n20\r\n$3\r\nmax\r\n$3\r\ninf\r\n",
+		},
+		{
+			name:     "ZINCRBY increments an existing member",
+			command:  "zincrby",
+			args:     []string{"board", "5", "bob"},
+			expected: "$2\r\n25\r\n",
+			scores:   "*4\r\n$5\r\nalice\r\n$2\r\n10\r\n$3\r\nbob\r\n$2\r\n25\r\n",
+		},
+		{
+			name:     "ZINCRBY adds a missing member",
+			command:  "zincrby",
+			args:     []string{"board", "-3", "carol"},
+			expected: "$2\r\n-3\r\n",
+			scores:   "*6\r\n$5\r\ncarol\r\n$2\r\n-3\r\n$5\r\nalice\r\n$2\r\n10\r\n$3\r\nbob\r\n$2\r\n20\r\n",
+		},
+		{
+			name:     "Error on NaN score",
+			command:  "zadd",
+			args:     []string{"board", "nan", "alice"},
+			expected: "-ERR value is not a valid float\r\n",
+			scores:   "*4\r\n$5\r\nalice\r\n$2\r\n10\r\n$3\r\nbob\r\n$2\r\n20\r\n",
+		},
+		{
+			name:     "Error on NX with XX",
+			command:  "zadd",
+			args:     []string{"board", "nx", "xx", "1", "alice"},
+			expected: "-ERR XX and NX options at the same time are not compatible\r\n",
+		},
+		{
+			name:     "Error on GT with LT",
+			command:  "zadd",
+			args:     []string{"board", "gt", "lt", "1", "alice"},
+			expected: "-ERR GT, LT, and/or NX options at the same time are not compatible\r\n",
+		},
+		{
+			name:     "Error on INCR with several pairs",
+			command:  "zadd",
+			args:     []string{"board", "incr", "1", "alice", "2", "bob"},
+			expected: "-ERR INCR option supports a single increment-element pair\r\n",
+		},
+		{
+			name:     "ZINCRBY error on invalid increment",
+			command:  "zincrby",
+			args:     []string{"board", "abc", "alice"},
+			expected: "-ERR value is not a valid float\r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store.Delete("board")
+			cli := setupZIncrByTestClient()
+			command.New("zadd", []string{"board", "10", "alice", "20", "bob"}).Execute(cli)
+
+			result := command.New(tt.command, tt.args).Execute(cli)
+			if string(result) != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, string(result))
+			}
+
+			if tt.scores != "" {
+				scores := command.New("zrange", []string{"board", "0", "-1", "withscores"}).Execute(cli)
+				if string(scores) != tt.scores {
+					t.Errorf("Expected scores %q, got %q", tt.scores, string(scores))
+				}
+			}
+		})
+	}
+}
+
+func TestZAddXXDoesNotCreateKey(t *testing.T) {
+	store.Delete("board")
+	cli := setupZIncrByTestClient()
+
+	result := command.New("zadd", []string{"board", "xx", "1", "alice"}).Execute(cli)
+	if string(result) != ":0\r\n" {
+		t.Errorf("Expected :0, got %q", string(result))
+	}
+	if _, exists := store.Get("board"); exists {
+		t.Error("Expected ZADD XX not to create the key")
+	}
+}
+
+func TestZIncrByRejectsNaNResult(t *testing.T) {
+	store.Delete("board")
+	cli := setupZIncrByTestClient()
+	command.New("zadd", []string{"board", "+inf", "max"}).Execute(cli)
+
+	result := command.New("zincrby", []string{"board", "-inf", "max"}).Execute(cli)
+	if string(result) != "-ERR resulting score is not a number (NaN)\r\n" {
+		t.Errorf("Expected NaN error, got %q", string(result))
+	}
+
+	score := command.New("zscore", []string{"board", "max"}).Execute(cli)
+	if string(score) != "$3\r\ninf\r\n" {
+		t.Errorf("Expected score to stay inf, got %q", string(score))
+	}
+}
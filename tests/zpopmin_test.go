@@ -0,0 +1,121 @@
+package tests
+
+import (
+	"net"
+	"testing"
+
+	command "github.com/SuchintK/GoDisKV/commands"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+func setupZPopMinTestClient() *client.Client {
+	conn1, _ := net.Pipe()
+	cli := client.New(conn1)
+	return &cli
+}
+
+// TestZPopCommands runs each command against a queue holding a=1, b=2 and c=3
+func TestZPopCommands(t *testing.T) {
+	tests := []struct {
+		name      string
+		command   string
+		args      []string
+		expected  string
+		remaining string // ZRANGE queue 0 -1 after the command
+	}{
+		{
+			name:      "Pop the lowest score",
+			command:   "zpopmin",
+			args:      []string{"queue"},
+			expected:  "*2\r\n$1\r\na\r\n$1\r\n1\r\n",
+			remaining: "*2\r\n$1\r\nb\r\n$1\r\nc\r\n",
+		},
+		{
+			name:      "Pop the highest scores with count",
+			command:   "zpopmax",
+			args:      []string{"queue", "2"},
+			expected:  "*4\r\n$1\r\nc\r\n$1\r\n3\r\n$1\r\nb\r\n$1\r\n2\r\n",
+			remaining: "*1\r\n$1\r\na\r\n",
+		},
+		{
+			name:      "Count larger than set empties it",
+			command:   "zpopmin",
+			args:      []string{"queue", "10"},
+			expected:  "*6\r\n$1\r\na\r\n$1\r\n1\r\n$1\r\nb\r\n$1\r\n2\r\n$1\r\nc\r\n$1\r\n3\r\n",
+			remaining: "*0\r\n",
+		},
+		{
+			name:      "Pop from missing key",
+			command:   "zpopmin",
+			args:      []string{"missing"},
+			expected:  "*0\r\n",
+			remaining: "*3\r\n$1\r\na\r\n$1\r\nb\r\n$1\r\nc\r\n",
+		},
+		{
+			name:      "ZMPOP from the first non-empty key",
+			command:   "zmpop",
+			args:      []string{"2", "missing", "queue", "max", "count", "2"},
+			expected:  "*2\r\n$5\r\nqueue\r\n*2\r\n*2\r\n$1\r\nc\r\n$1\r\n3\r\n*2\r\n$1\r\nb\r\n$1\r\n2\r\n",
+			remaining: "*1\r\n$1\r\na\r\n",
+		},
+		{
+			name:      "ZMPOP on empty keys",
+			command:   "zmpop",
+			args:      []string{"1", "missing", "min"},
+			expected:  "*-1\r\n",
+			remaining: "*3\r\n$1\r\na\r\n$1\r\nb\r\n$1\r\nc\r\n",
+		},
+		{
+			name:     "Error on negative count",
+			command:  "zpopmin",
+			args:     []string{"queue", "-1"},
+			expected: "-ERR value is out of range, must be positive\r\n",
+		},
+		{
+			name:     "ZMPOP error on invalid direction",
+			command:  "zmpop",
+			args:     []string{"1", "queue", "left"},
+			expected: "-syntax error\r\n",
+		},
+		{
+			name:     "Error on wrong type",
+			command:  "zpopmax",
+			args:     []string{"notazset"},
+			expected: "-WRONGTYPE Operation against a key holding the wrong kind of value\r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store.Delete("queue")
+			store.Delete("notazset")
+			cli := setupZPopMinTestClient()
+			command.New("zadd", []string{"queue", "1", "a", "2", "b", "3", "c"}).Execute(cli)
+			command.New("set", []string{"notazset", "value"}).Execute(cli)
+
+			result := command.New(tt.command, tt.args).Execute(cli)
+			if string(result) != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, string(result))
+			}
+
+			if tt.remaining != "" {
+				remaining := command.New("zrange", []string{"queue", "0", "-1"}).Execute(cli)
+				if string(remaining) != tt.remaining {
+					t.Errorf("Expected remaining %q, got %q", tt.remaining, string(remaining))
+				}
+			}
+		})
+	}
+}
+
+func TestZPopDeletesEmptiedKey(t *testing.T) {
+	store.Delete("queue")
+	cli := setupZPopMinTestClient()
+	command.New("zadd", []string{"queue", "1", "a"}).Execute(cli)
+
+	command.New("zpopmax", []string{"queue"}).Execute(cli)
+	if _, exists := store.Get("queue"); exists {
+		t.Error("Expected key to be deleted after popping its last member")
+	}
+}
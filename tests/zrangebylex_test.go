@@ -0,0 +1,97 @@
+package tests
+
+import (
+	"net"
+	"testing"
+
+	command "github.com/SuchintK/GoDisKV/commands"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+func setupZRangeByLexTestClient() *client.Client {
+	conn1, _ := net.Pipe()
+	cli := client.New(conn1)
+	return &cli
+}
+
+func TestZRangeByLexCommand(t *testing.T) {
+	setup := func() {
+		store.Delete("names")
+		cli := setupZRangeByLexTestClient()
+		command.New("zadd", []string{"names", "0", "alice", "0", "bob", "0", "carol", "0", "dave", "0", "eve"}).Execute(cli)
+	}
+
+	tests := []struct {
+		name     string
+		command  string
+		args     []string
+		expected string
+	}{
+		{
+			name:     "Whole set",
+			command:  "zrangebylex",
+			args:     []string{"names", "-", "+"},
+			expected: "*5\r\n$5\r\nalice\r\n$3\r\nbob\r\n$5\r\ncarol\r\n$4\r\ndave\r\n$3\r\neve\r\n",
+		},
+		{
+			name:     "Inclusive and exclusive bounds",
+			command:  "zrangebylex",
+			args:     []string{"names", "[bob", "(dave"},
+			expected: "*2\r\n$3\r\nbob\r\n$5\r\ncarol\r\n",
+		},
+		{
+			name:     "Prefix range",
+			command:  "zrangebylex",
+			args:     []string{"names", "[c", "(d"},
+			expected: "*1\r\n$5\r\ncarol\r\n",
+		},
+		{
+			name:     "Limit",
+			command:  "zrangebylex",
+			args:     []string{"names", "-", "+", "limit", "1", "2"},
+			expected: "*2\r\n$3\r\nbob\r\n$5\r\ncarol\r\n",
+		},
+		{
+			name:     "Reverse range takes max first",
+			command:  "zrevrangebylex",
+			args:     []string{"names", "(dave", "-"},
+			expected: "*3\r\n$5\r\ncarol\r\n$3\r\nbob\r\n$5\r\nalice\r\n",
+		},
+		{
+			name:     "Unified ZRANGE BYLEX",
+			command:  "zrange",
+			args:     []string{"names", "(alice", "[carol", "bylex"},
+			expected: "*2\r\n$3\r\nbob\r\n$5\r\ncarol\r\n",
+		},
+		{
+			name:     "Count within lexicographic range",
+			command:  "zlexcount",
+			args:     []string{"names", "[b", "+"},
+			expected: ":4\r\n",
+		},
+		{
+			name:     "Error on invalid lex bound",
+			command:  "zrangebylex",
+			args:     []string{"names", "bob", "+"},
+			expected: "-ERR min or max not valid string range item\r\n",
+		},
+		{
+			name:     "Error on WITHSCORES with BYLEX",
+			command:  "zrange",
+			args:     []string{"names", "-", "+", "bylex", "withscores"},
+			expected: "-ERR syntax error, WITHSCORES not supported in combination with BYLEX\r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setup()
+			cli := setupZRangeByLexTestClient()
+			result := command.New(tt.command, tt.args).Execute(cli)
+			if string(result) != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, string(result))
+			}
+		})
+	}
+}
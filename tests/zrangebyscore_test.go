@@ -0,0 +1,142 @@
+package tests
+
+import (
+	"net"
+	"testing"
+
+	command "github.com/SuchintK/GoDisKV/commands"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+func setupZRangeByScoreTestClient() *client.Client {
+	conn1, _ := net.Pipe()
+	cli := client.New(conn1)
+	return &cli
+}
+
+func TestZRangeByScoreCommand(t *testing.T) {
+	setup := func() {
+		store.Delete("scores")
+		cli := setupZRangeByScoreTestClient()
+		command.New("zadd", []string{"scores", "1", "a", "2", "b", "2.5", "c", "3", "d", "5", "e"}).Execute(cli)
+	}
+
+	tests := []struct {
+		name     string
+		command  string
+		args     []string
+		expected string
+	}{
+		{
+			name:     "Inclusive bounds",
+			command:  "zrangebyscore",
+			args:     []string{"scores", "2", "3"},
+			expected: "*3\r\n$1\r\nb\r\n$1\r\nc\r\n$1\r\nd\r\n",
+		},
+		{
+			name:     "Exclusive bounds",
+			command:  "zrangebyscore",
+			args:     []string{"scores", "(2", "(3"},
+			expected: "*1\r\n$1\r\nc\r\n",
+		},
+		{
+			name:     "Infinite bounds with scores",
+			command:  "zrangebyscore",
+			args:     []string{"scores", "-inf", "+inf", "withscores"},
+			expected: "*10\r\n$1\r\na\r\n$1\r\n1\r\n$1\r\nb\r\n$1\r\n2\r\n$1\r\nc\r\n$3\r\n2.5\r\n$1\r\nd\r\n$1\r\n3\r\n$1\r\ne\r\n$1\r\n5\r\n",
+		},
+		{
+			name:     "Limit offset and count",
+			command:  "zrangebyscore",
+			args:     []string{"scores", "-inf", "+inf", "limit", "1", "2"},
+			expected: "*2\r\n$1\r\nb\r\n$1\r\nc\r\n",
+		},
+		{
+			name:     "Negative limit count returns the rest",
+			command:  "zrangebyscore",
+			args:     []string{"scores", "-inf", "+inf", "limit", "3", "-1"},
+			expected: "*2\r\n$1\r\nd\r\n$1\r\ne\r\n",
+		},
+		{
+			name:     "Empty range",
+			command:  "zrangebyscore",
+			args:     []string{"scores", "(5", "+inf"},
+			expected: "*0\r\n",
+		},
+		{
+			name:     "Reverse range takes max first",
+			command:  "zrevrangebyscore",
+			args:     []string{"scores", "3", "(1"},
+			expected: "*3\r\n$1\r\nd\r\n$1\r\nc\r\n$1\r\nb\r\n",
+		},
+		{
+			name:     "Reverse range with limit",
+			command:  "zrevrangebyscore",
+			args:     []string{"scores", "+inf", "-inf", "withscores", "limit", "1", "1"},
+			expected: "*2\r\n$1\r\nd\r\n$1\r\n3\r\n",
+		},
+		{
+			name:     "Unified ZRANGE BYSCORE REV LIMIT",
+			command:  "zrange",
+			args:     []string{"scores", "+inf", "2", "byscore", "rev", "limit", "0", "2"},
+			expected: "*2\r\n$1\r\ne\r\n$1\r\nd\r\n",
+		},
+		{
+			name:     "Unified ZRANGE REV by rank",
+			command:  "zrange",
+			args:     []string{"scores", "0", "1", "rev"},
+			expected: "*2\r\n$1\r\ne\r\n$1\r\nd\r\n",
+		},
+		{
+			name:     "Count within score range",
+			command:  "zcount",
+			args:     []string{"scores", "(1", "3"},
+			expected: ":3\r\n",
+		},
+		{
+			name:     "Count on missing key",
+			command:  "zcount",
+			args:     []string{"missing", "-inf", "+inf"},
+			expected: ":0\r\n",
+		},
+		{
+			name:     "Error on invalid score bound",
+			command:  "zrangebyscore",
+			args:     []string{"scores", "abc", "3"},
+			expected: "-ERR min or max is not a float\r\n",
+		},
+		{
+			name:     "Error on LIMIT without BYSCORE or BYLEX",
+			command:  "zrange",
+			args:     []string{"scores", "0", "-1", "limit", "0", "1"},
+			expected: "-ERR syntax error, LIMIT is only supported in combination with either BYSCORE or BYLEX\r\n",
+		},
+		{
+			name:     "Error on unknown option",
+			command:  "zrangebyscore",
+			args:     []string{"scores", "0", "1", "rev"},
+			expected: "-syntax error\r\n",
+		},
+		{
+			name:     "Error on wrong type",
+			command:  "zcount",
+			args:     []string{"notazset", "0", "1"},
+			expected: "-WRONGTYPE Operation against a key holding the wrong kind of value\r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setup()
+			cli := setupZRangeByScoreTestClient()
+			store.Delete("notazset")
+			command.New("set", []string{"notazset", "value"}).Execute(cli)
+
+			result := command.New(tt.command, tt.args).Execute(cli)
+			if string(result) != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, string(result))
+			}
+		})
+	}
+}
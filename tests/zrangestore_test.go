@@ -0,0 +1,76 @@
+package tests
+
+import (
+	"net"
+	"testing"
+
+	command "github.com/SuchintK/GoDisKV/commands"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+func setupZRangeStoreTestClient() *client.Client {
+	conn1, _ := net.Pipe()
+	cli := client.New(conn1)
+	return &cli
+}
+
+func TestZRangeStoreCommand(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		expected string
+		stored   string // ZRANGE dst 0 -1 WITHSCORES after the command
+	}{
+		{
+			name:     "Store a rank range",
+			args:     []string{"dst", "src", "1", "2"},
+			expected: ":2\r\n",
+			stored:   "*4\r\n$1\r\nb\r\n$1\r\n2\r\n$1\r\nc\r\n$1\r\n3\r\n",
+		},
+		{
+			name:     "Store a reversed score range with limit",
+			args:     []string{"dst", "src", "+inf", "(1", "byscore", "rev", "limit", "0", "2"},
+			expected: ":2\r\n",
+			stored:   "*4\r\n$1\r\nc\r\n$1\r\n3\r\n$1\r\nd\r\n$1\r\n4\r\n",
+		},
+		{
+			name:     "Empty result deletes destination",
+			args:     []string{"dst", "src", "10", "20", "byscore"},
+			expected: ":0\r\n",
+			stored:   "*0\r\n",
+		},
+		{
+			name:     "Missing source deletes destination",
+			args:     []string{"dst", "missing", "0", "-1"},
+			expected: ":0\r\n",
+			stored:   "*0\r\n",
+		},
+		{
+			name:     "Error on WITHSCORES",
+			args:     []string{"dst", "src", "0", "-1", "withscores"},
+			expected: "-syntax error\r\n",
+			stored:   "*2\r\n$3\r\nold\r\n$1\r\n9\r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store.Delete("src")
+			store.Delete("dst")
+			cli := setupZRangeStoreTestClient()
+			command.New("zadd", []string{"src", "1", "a", "2", "b", "3", "c", "4", "d"}).Execute(cli)
+			command.New("zadd", []string{"dst", "9", "old"}).Execute(cli)
+
+			result := command.New("zrangestore", tt.args).Execute(cli)
+			if string(result) != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, string(result))
+			}
+
+			stored := command.New("zrange", []string{"dst", "0", "-1", "withscores"}).Execute(cli)
+			if string(stored) != tt.stored {
+				t.Errorf("Expected destination %q, got %q", tt.stored, string(stored))
+			}
+		})
+	}
+}
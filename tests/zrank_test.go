@@ -81,7 +81,7 @@ func TestZRankCommand(t *testing.T) {
 			name: "Error on wrong number of arguments - too many",
 			setup: func() {
 			},
-			args:     []string{"myzset", "member", "extra"},
+			args:     []string{"myzset", "member", "WITHSCORE", "extra"},
 			expected: "-wrong number of arguments\r\n",
 		},
 		{
@@ -115,6 +115,16 @@ func TestZRankCommand(t *testing.T) {
 			args:     []string{"myzset", "cherry"},
 			expected: ":2\r\n",
 		},
+		{
+			name: "Error on unknown option",
+			setup: func() {
+				store.Delete("myzset")
+				cli := setupZRankTestClient()
+				command.New("zadd", []string{"myzset", "1", "one"}).Execute(cli)
+			},
+			args:     []string{"myzset", "one", "FOO"},
+			expected: "-syntax error\r\n",
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,259 @@
+package tests
+
+import (
+	"net"
+	"testing"
+
+	command "github.com/SuchintK/GoDisKV/commands"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+func setupZRevRankTestClient() *client.Client {
+	conn1, _ := net.Pipe()
+	cli := client.New(conn1)
+	return &cli
+}
+
+// TestZSetReadCommands runs each command against scores holding a=1, b=2, c=3 and d=4
+func TestZSetReadCommands(t *testing.T) {
+	tests := []struct {
+		name     string
+		command  string
+		args     []string
+		expected string
+	}{
+		{
+			name:     "ZREVRANK of the highest score",
+			command:  "zrevrank",
+			args:     []string{"scores", "d"},
+			expected: ":0\r\n",
+		},
+		{
+			name:     "ZREVRANK with score",
+			command:  "zrevrank",
+			args:     []string{"scores", "b", "withscore"},
+			expected: "*2\r\n:2\r\n$1\r\n2\r\n",
+		},
+		{
+			name:     "ZRANK with score",
+			command:  "zrank",
+			args:     []string{"scores", "b", "withscore"},
+			expected: "*2\r\n:1\r\n$1\r\n2\r\n",
+		},
+		{
+			name:     "ZREVRANK with score of missing member",
+			command:  "zrevrank",
+			args:     []string{"scores", "z", "withscore"},
+			expected: "*-1\r\n",
+		},
+		{
+			name:     "ZREVRANGE with scores",
+			command:  "zrevrange",
+			args:     []string{"scores", "0", "1", "withscores"},
+			expected: "*4\r\n$1\r\nd\r\n$1\r\n4\r\n$1\r\nc\r\n$1\r\n3\r\n",
+		},
+		{
+			name:     "ZREVRANGE with negative indexes",
+			command:  "zrevrange",
+			args:     []string{"scores", "-2", "-1"},
+			expected: "*2\r\n$1\r\nb\r\n$1\r\na\r\n",
+		},
+		{
+			name:     "ZREVRANGE rejects LIMIT",
+			command:  "zrevrange",
+			args:     []string{"scores", "0", "-1", "limit", "0", "1"},
+			expected: "-ERR syntax error, LIMIT is only supported in combination with either BYSCORE or BYLEX\r\n",
+		},
+		{
+			name:     "ZMSCORE with missing members",
+			command:  "zmscore",
+			args:     []string{"scores", "a", "z", "d"},
+			expected: "*3\r\n$1\r\n1\r\n$-1\r\n$1\r\n4\r\n",
+		},
+		{
+			name:     "ZMSCORE on missing key",
+			command:  "zmscore",
+			args:     []string{"missing", "a"},
+			expected: "*1\r\n$-1\r\n",
+		},
+		{
+			name:     "ZRANDMEMBER count larger than set returns every member",
+			command:  "zrandmember",
+			args:     []string{"single", "5", "withscores"},
+			expected: "*2\r\n$1\r\nx\r\n$1\r\n7\r\n",
+		},
+		{
+			name:     "ZRANDMEMBER negative count repeats members",
+			command:  "zrandmember",
+			args:     []string{"single", "-3"},
+			expected: "*3\r\n$1\r\nx\r\n$1\r\nx\r\n$1\r\nx\r\n",
+		},
+		{
+			name:     "ZRANDMEMBER without count on a single member set",
+			command:  "zrandmember",
+			args:     []string{"single"},
+			expected: "$1\r\nx\r\n",
+		},
+		{
+			name:     "ZRANDMEMBER rejects a count out of range",
+			command:  "zrandmember",
+			args:     []string{"single", "-9223372036854775808"},
+			expected: "-ERR value is out of range\r\n",
+		},
+		{
+			name:     "ZRANDMEMBER on missing key",
+			command:  "zrandmember",
+			args:     []string{"missing"},
+			expected: "$-1\r\n",
+		},
+		{
+			name:     "ZRANDMEMBER error on invalid option",
+			command:  "zrandmember",
+			args:     []string{"scores", "1", "withscore"},
+			expected: "-syntax error\r\n",
+		},
+		{
+			name:     "ZSCAN returns members with scores",
+			command:  "zscan",
+			args:     []string{"scores", "0", "count", "2"},
+			expected: "*2\r\n$1\r\n2\r\n*4\r\n$1\r\na\r\n$1\r\n1\r\n$1\r\nb\r\n$1\r\n2\r\n",
+		},
+		{
+			name:     "ZSCAN with match",
+			command:  "zscan",
+			args:     []string{"scores", "0", "match", "c"},
+			expected: "*2\r\n$1\r\n0\r\n*2\r\n$1\r\nc\r\n$1\r\n3\r\n",
+		},
+		{
+			name:     "Error on wrong type",
+			command:  "zmscore",
+			args:     []string{"notazset", "a"},
+			expected: "-WRONGTYPE Operation against a key holding the wrong kind of value\r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store.Delete("scores")
+			store.Delete("single")
+			store.Delete("notazset")
+			cli := setupZRevRankTestClient()
+			command.New("zadd", []string{"scores", "1", "a", "2", "b", "3", "c", "4", "d"}).Execute(cli)
+			command.New("zadd", []string{"single", "7", "x"}).Execute(cli)
+			command.New("set", []string{"notazset", "value"}).Execute(cli)
+
+			result := command.New(tt.command, tt.args).Execute(cli)
+			if string(result) != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, string(result))
+			}
+		})
+	}
+}
+
+// TestZRemRangeCommands runs each command against scores holding a=1, b=2, c=3 and d=4
+func TestZRemRangeCommands(t *testing.T) {
+	tests := []struct {
+		name      string
+		command   string
+		args      []string
+		expected  string
+		remaining string // ZRANGE scores 0 -1 after the command
+	}{
+		{
+			name:      "Remove by rank",
+			command:   "zremrangebyrank",
+			args:      []string{"scores", "0", "-3"},
+			expected:  ":2\r\n",
+			remaining: "*2\r\n$1\r\nc\r\n$1\r\nd\r\n",
+		},
+		{
+			name:      "Remove by exclusive score range",
+			command:   "zremrangebyscore",
+			args:      []string{"scores", "(1", "3"},
+			expected:  ":2\r\n",
+			remaining: "*2\r\n$1\r\na\r\n$1\r\nd\r\n",
+		},
+		{
+			name:      "Remove by lexicographic range",
+			command:   "zremrangebylex",
+			args:      []string{"scores", "[b", "+"},
+			expected:  ":3\r\n",
+			remaining: "*1\r\n$1\r\na\r\n",
+		},
+		{
+			name:      "Remove an empty range",
+			command:   "zremrangebyscore",
+			args:      []string{"scores", "10", "+inf"},
+			expected:  ":0\r\n",
+			remaining: "*4\r\n$1\r\na\r\n$1\r\nb\r\n$1\r\nc\r\n$1\r\nd\r\n",
+		},
+		{
+			name:     "Error on invalid score bound",
+			command:  "zremrangebyscore",
+			args:     []string{"scores", "low", "high"},
+			expected: "-ERR min or max is not a float\r\n",
+		},
+		{
+			name:     "Error on invalid rank",
+			command:  "zremrangebyrank",
+			args:     []string{"scores", "first", "1"},
+			expected: "-ERR value is not an integer or out of range\r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store.Delete("scores")
+			cli := setupZRevRankTestClient()
+			command.New("zadd", []string{"scores", "1", "a", "2", "b", "3", "c", "4", "d"}).Execute(cli)
+
+			result := command.New(tt.command, tt.args).Execute(cli)
+			if string(result) != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, string(result))
+			}
+
+			if tt.remaining != "" {
+				remaining := command.New("zrange", []string{"scores", "0", "-1"}).Execute(cli)
+				if string(remaining) != tt.remaining {
+					t.Errorf("Expected remaining %q, got %q", tt.remaining, string(remaining))
+				}
+			}
+		})
+	}
+}
+
+func TestZRemRangeDeletesEmptiedKey(t *testing.T) {
+	store.Delete("scores")
+	cli := setupZRevRankTestClient()
+	command.New("zadd", []string{"scores", "1", "a", "2", "b"}).Execute(cli)
+
+	command.New("zremrangebyrank", []string{"scores", "0", "-1"}).Execute(cli)
+	if _, exists := store.Get("scores"); exists {
+		t.Error("Expected key to be deleted after removing every member")
+	}
+}
+
+func TestZScanCursorSurvivesUpdates(t *testing.T) {
+	store.Delete("scores")
+	cli := setupZRevRankTestClient()
+	command.New("zadd", []string{"scores", "1", "a", "2", "b", "3", "c", "4", "d"}).Execute(cli)
+
+	steps := []struct {
+		command  string
+		args     []string
+		expected string
+	}{
+		{"zscan", []string{"scores", "0", "COUNT", "2"}, "*2\r\n$1\r\n2\r\n*4\r\n$1\r\na\r\n$1\r\n1\r\n$1\r\nb\r\n$1\r\n2\r\n"},
+		{"zadd", []string{"scores", "0", "d"}, ":0\r\n"},
+		{"zrem", []string{"scores", "a"}, ":1\r\n"},
+		{"zscan", []string{"scores", "2"}, "*2\r\n$1\r\n0\r\n*4\r\n$1\r\nc\r\n$1\r\n3\r\n$1\r\nd\r\n$1\r\n0\r\n"},
+	}
+
+	for _, step := range steps {
+		result := command.New(step.command, step.args).Execute(cli)
+		if string(result) != step.expected {
+			t.Errorf("%s %v: expected %q, got %q", step.command, step.args, step.expected, string(result))
+		}
+	}
+}
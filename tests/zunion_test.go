@@ -0,0 +1,210 @@
+package tests
+
+import (
+	"net"
+	"testing"
+
+	command "github.com/SuchintK/GoDisKV/commands"
+	"github.com/SuchintK/GoDisKV/resp/client"
+	"github.com/SuchintK/GoDisKV/store"
+)
+
+func setupZUnionTestClient() *client.Client {
+	conn1, _ := net.Pipe()
+	cli := client.New(conn1)
+	return &cli
+}
+
+// TestZSetAlgebra runs each command against
+// daily = {alice: 1, bob: 2}, weekly = {bob: 10, carol: 20} and tags = {alice, carol} (a plain set)
+func TestZSetAlgebra(t *testing.T) {
+	tests := []struct {
+		name     string
+		command  string
+		args     []string
+		expected string
+	}{
+		{
+			name:     "Union sums scores",
+			command:  "zunion",
+			args:     []string{"2", "daily", "weekly", "withscores"},
+			expected: "*6\r\n$5\r\nalice\r\n$1\r\n1\r\n$3\r\nbob\r\n$2\r\n12\r\n$5\r\ncarol\r\n$2\r\n20\r\n",
+		},
+		{
+			name:     "Union with weights",
+			command:  "zunion",
+			args:     []string{"2", "daily", "weekly", "weights", "10", "0.5", "withscores"},
+			expected: "*6\r\n$5\r\nalice\r\n$2\r\n10\r\n$5\r\ncarol\r\n$2\r\n10\r\n$3\r\nbob\r\n$2\r\n25\r\n",
+		},
+		{
+			name:     "Union with MAX aggregate",
+			command:  "zunion",
+			args:     []string{"2", "daily", "weekly", "aggregate", "max", "withscores"},
+			expected: "*6\r\n$5\r\nalice\r\n$1\r\n1\r\n$3\r\nbob\r\n$2\r\n10\r\n$5\r\ncarol\r\n$2\r\n20\r\n",
+		},
+		{
+			name:     "Intersection with MIN aggregate",
+			command:  "zinter",
+			args:     []string{"2", "daily", "weekly", "aggregate", "min", "withscores"},
+			expected: "*2\r\n$3\r\nbob\r\n$1\r\n2\r\n",
+		},
+		{
+			name:     "Plain sets count as score 1",
+			command:  "zinter",
+			args:     []string{"2", "weekly", "tags", "withscores"},
+			expected: "*2\r\n$5\r\ncarol\r\n$2\r\n21\r\n",
+		},
+		{
+			name:     "Intersection with missing key is empty",
+			command:  "zinter",
+			args:     []string{"2", "daily", "missing"},
+			expected: "*0\r\n",
+		},
+		{
+			name:     "Difference keeps first scores",
+			command:  "zdiff",
+			args:     []string{"2", "daily", "weekly", "withscores"},
+			expected: "*2\r\n$5\r\nalice\r\n$1\r\n1\r\n",
+		},
+		{
+			name:     "Intersection cardinality",
+			command:  "zintercard",
+			args:     []string{"2", "daily", "weekly"},
+			expected: ":1\r\n",
+		},
+		{
+			name:     "Intersection cardinality with limit",
+			command:  "zintercard",
+			args:     []string{"1", "weekly", "limit", "1"},
+			expected: ":1\r\n",
+		},
+		{
+			name:     "Error on zero numkeys",
+			command:  "zunion",
+			args:     []string{"0", "daily"},
+			expected: "-ERR at least 1 input key is needed for 'zunion' command\r\n",
+		},
+		{
+			name:     "Error on invalid weight",
+			command:  "zinter",
+			args:     []string{"2", "daily", "weekly", "weights", "1", "x"},
+			expected: "-ERR weight value is not a float\r\n",
+		},
+		{
+			name:     "Error on WEIGHTS with ZDIFF",
+			command:  "zdiff",
+			args:     []string{"2", "daily", "weekly", "weights", "1", "1"},
+			expected: "-syntax error\r\n",
+		},
+		{
+			name:     "Error on numkeys larger than keys",
+			command:  "zunion",
+			args:     []string{"3", "daily", "weekly"},
+			expected: "-syntax error\r\n",
+		},
+		{
+			name:     "Error on numkeys as large as the integer range",
+			command:  "zunion",
+			args:     []string{"9223372036854775807", "daily"},
+			expected: "-syntax error\r\n",
+		},
+		{
+			name:     "Error on ZINTERCARD numkeys as large as the integer range",
+			command:  "zintercard",
+			args:     []string{"9223372036854775807", "daily"},
+			expected: "-syntax error\r\n",
+		},
+		{
+			name:     "Error on wrong type",
+			command:  "zunion",
+			args:     []string{"2", "daily", "notazset"},
+			expected: "-WRONGTYPE Operation against a key holding the wrong kind of value\r\n",
+		},
+		{
+			name:     "ZINTERCARD error on negative limit",
+			command:  "zintercard",
+			args:     []string{"1", "daily", "limit", "-1"},
+			expected: "-ERR LIMIT can't be negative\r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setupZSetAlgebraInputs()
+			cli := setupZUnionTestClient()
+			result := command.New(tt.command, tt.args).Execute(cli)
+			if string(result) != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, string(result))
+			}
+		})
+	}
+}
+
+func TestZSetAlgebraStore(t *testing.T) {
+	tests := []struct {
+		name     string
+		command  string
+		args     []string
+		expected string
+		stored   string // ZRANGE dst 0 -1 WITHSCORES after the command
+	}{
+		{
+			name:     "ZUNIONSTORE with weights",
+			command:  "zunionstore",
+			args:     []string{"dst", "2", "daily", "weekly", "weights", "2", "1"},
+			expected: ":3\r\n",
+			stored:   "*6\r\n$5\r\nalice\r\n$1\r\n2\r\n$3\r\nbob\r\n$2\r\n14\r\n$5\r\ncarol\r\n$2\r\n20\r\n",
+		},
+		{
+			name:     "ZINTERSTORE",
+			command:  "zinterstore",
+			args:     []string{"dst", "2", "daily", "weekly"},
+			expected: ":1\r\n",
+			stored:   "*2\r\n$3\r\nbob\r\n$2\r\n12\r\n",
+		},
+		{
+			name:     "ZDIFFSTORE with empty result deletes destination",
+			command:  "zdiffstore",
+			args:     []string{"dst", "2", "daily", "daily"},
+			expected: ":0\r\n",
+			stored:   "*0\r\n",
+		},
+		{
+			name:     "Error on WITHSCORES",
+			command:  "zunionstore",
+			args:     []string{"dst", "1", "daily", "withscores"},
+			expected: "-syntax error\r\n",
+			stored:   "*2\r\n$3\r\nold\r\n$1\r\n1\r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setupZSetAlgebraInputs()
+			store.Delete("dst")
+			cli := setupZUnionTestClient()
+			command.New("zadd", []string{"dst", "1", "old"}).Execute(cli)
+
+			result := command.New(tt.command, tt.args).Execute(cli)
+			if string(result) != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, string(result))
+			}
+
+			stored := command.New("zrange", []string{"dst", "0", "-1", "withscores"}).Execute(cli)
+			if string(stored) != tt.stored {
+				t.Errorf("Expected destination %q, got %q", tt.stored, string(stored))
+			}
+		})
+	}
+}
+
+func setupZSetAlgebraInputs() {
+	for _, key := range []string{"daily", "weekly", "tags", "notazset", "missing"} {
+		store.Delete(key)
+	}
+	cli := setupZUnionTestClient()
+	command.New("zadd", []string{"daily", "1", "alice", "2", "bob"}).Execute(cli)
+	command.New("zadd", []string{"weekly", "10", "bob", "20", "carol"}).Execute(cli)
+	command.New("sadd", []string{"tags", "alice", "carol"}).Execute(cli)
+	command.New("set", []string{"notazset", "value"}).Execute(cli)
+}